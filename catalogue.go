@@ -0,0 +1,181 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Built-in device families, each has its own dictionary in ./teltonikajson/
+const (
+	FamilyFMBXY  = "FMBXY"  // FMB, FMC, FMM, FMU, FMP, FM30, TMT250 and GH5200 devices
+	FamilyFM64   = "FM64"   // FMB640 and FMX64 devices
+	FamilyFM36   = "FM36"   // FM36 devices
+	FamilyFM11XY = "FM11XY" // FM1100 and FM1200 devices
+)
+
+// Model describes a device model in the catalogue
+type Model struct {
+	Name       string   // Model name, for example FMB920
+	Family     string   // Device family of the model
+	Compatible []string // Models with the same IO elements, used for HWSupport of dictionaries which do not list Name
+}
+
+// catalogue holds known models by upper case name
+var catalogue = struct {
+	sync.RWMutex
+	models map[string]Model
+}{models: make(map[string]Model)}
+
+func init() {
+	models := []Model{
+		// FMBXY
+		{Name: "FMB001", Family: FamilyFMBXY},
+		{Name: "FMB002", Family: FamilyFMBXY, Compatible: []string{"FMB001"}},
+		{Name: "FMB003", Family: FamilyFMBXY, Compatible: []string{"FMB001"}},
+		{Name: "FMB010", Family: FamilyFMBXY},
+		{Name: "FMB020", Family: FamilyFMBXY, Compatible: []string{"FMB010"}},
+		{Name: "FMB100", Family: FamilyFMBXY},
+		{Name: "FMB110", Family: FamilyFMBXY},
+		{Name: "FMB120", Family: FamilyFMBXY},
+		{Name: "FMB122", Family: FamilyFMBXY},
+		{Name: "FMB125", Family: FamilyFMBXY},
+		{Name: "FMB130", Family: FamilyFMBXY, Compatible: []string{"FMB120"}},
+		{Name: "FMB140", Family: FamilyFMBXY, Compatible: []string{"FMB120"}},
+		{Name: "FMB900", Family: FamilyFMBXY},
+		{Name: "FMB910", Family: FamilyFMBXY, Compatible: []string{"FMB900"}},
+		{Name: "FMB920", Family: FamilyFMBXY},
+		{Name: "FMB962", Family: FamilyFMBXY},
+		{Name: "FMB964", Family: FamilyFMBXY},
+		{Name: "FMC001", Family: FamilyFMBXY, Compatible: []string{"FMB001"}},
+		{Name: "FMC125", Family: FamilyFMBXY, Compatible: []string{"FMB125"}},
+		{Name: "FMC130", Family: FamilyFMBXY, Compatible: []string{"FMB130", "FMB120"}},
+		{Name: "FMC920", Family: FamilyFMBXY, Compatible: []string{"FMB920"}},
+		{Name: "FMM001", Family: FamilyFMBXY, Compatible: []string{"FMB001"}},
+		{Name: "FMM125", Family: FamilyFMBXY, Compatible: []string{"FMB125"}},
+		{Name: "FMM130", Family: FamilyFMBXY, Compatible: []string{"FMB130", "FMB120"}},
+		{Name: "FMM920", Family: FamilyFMBXY, Compatible: []string{"FMB920"}},
+		{Name: "FMU125", Family: FamilyFMBXY, Compatible: []string{"FMB125"}},
+		{Name: "FMU130", Family: FamilyFMBXY, Compatible: []string{"FMB130", "FMB120"}},
+		{Name: "FMP100", Family: FamilyFMBXY, Compatible: []string{"FMB001"}},
+		{Name: "FM3001", Family: FamilyFMBXY},
+		{Name: "FM3010", Family: FamilyFMBXY},
+		{Name: "TMT250", Family: FamilyFMBXY},
+		{Name: "GH5200", Family: FamilyFMBXY},
+		// FM64
+		{Name: "FMB640", Family: FamilyFM64},
+		{Name: "FMB641", Family: FamilyFM64, Compatible: []string{"FMB640"}},
+		{Name: "FMC640", Family: FamilyFM64, Compatible: []string{"FMB640"}},
+		{Name: "FMM640", Family: FamilyFM64, Compatible: []string{"FMB640"}},
+		// FM36
+		{Name: "FM3612", Family: FamilyFM36},
+		{Name: "FM3622", Family: FamilyFM36, Compatible: []string{"FM3612"}},
+		{Name: "FM36M1", Family: FamilyFM36},
+		// FM11XY
+		{Name: "FM1100", Family: FamilyFM11XY},
+		{Name: "FM1110", Family: FamilyFM11XY},
+		{Name: "FM1120", Family: FamilyFM11XY},
+		{Name: "FM1122", Family: FamilyFM11XY},
+		{Name: "FM1125", Family: FamilyFM11XY},
+		{Name: "FM1200", Family: FamilyFM11XY},
+		{Name: "FM1202", Family: FamilyFM11XY},
+	}
+	for _, m := range models {
+		catalogue.models[m.Name] = m
+	}
+}
+
+// LookupModel return a model from the catalogue, name is case insensitive
+func LookupModel(name string) (Model, bool) {
+	catalogue.RLock()
+	defer catalogue.RUnlock()
+	m, ok := catalogue.models[strings.ToUpper(strings.TrimSpace(name))]
+	return m, ok
+}
+
+// ModelFamily return a device family of the model
+func ModelFamily(name string) (string, bool) {
+	m, ok := LookupModel(name)
+	return m.Family, ok
+}
+
+// RegisterModel adds or replaces a model in the catalogue, it is safe for concurrent use
+func RegisterModel(m Model) error {
+	m.Name = strings.ToUpper(strings.TrimSpace(m.Name))
+	if m.Name == "" || m.Family == "" {
+		return fmt.Errorf("Unable to register model %q, missing name or family", m.Name)
+	}
+
+	catalogue.Lock()
+	defer catalogue.Unlock()
+	catalogue.models[m.Name] = m
+	return nil
+}
+
+// Models return all models in the catalogue sorted by name
+func Models() []Model {
+	catalogue.RLock()
+	defer catalogue.RUnlock()
+	models := make([]Model, 0, len(catalogue.models))
+	for _, m := range catalogue.models {
+		models = append(models, m)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+	return models
+}
+
+// Supports reports whether the model can produce the IO element according to HWSupport,
+// X and Y at the end of a listed model are wildcards (FMB1XY matches FMB120), HWSupport without any model means all models
+func (k *AvlEncodeKey) Supports(m Model) bool {
+	listed := false
+	for _, hw := range strings.Split(k.HWSupport, ",") {
+		hw = strings.ToUpper(strings.TrimSpace(hw))
+		if !isModelName(hw) {
+			continue
+		}
+		listed = true
+		if matchModel(hw, m.Name) {
+			return true
+		}
+		for _, c := range m.Compatible {
+			if matchModel(hw, c) {
+				return true
+			}
+		}
+	}
+	return !listed
+}
+
+// isModelName reports whether s looks like a model name, for example FMB920, descriptions like "HW with gyro (LSM6DSL)" are not models
+func isModelName(s string) bool {
+	if len(s) < 4 {
+		return false
+	}
+	digits := false
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			digits = true
+		case c >= 'A' && c <= 'Z':
+		default:
+			return false
+		}
+	}
+	return digits || strings.ContainsAny(s[3:], "XY")
+}
+
+// matchModel reports whether model matches pattern listed in HWSupport
+func matchModel(pattern string, model string) bool {
+	model = strings.ToUpper(model)
+	prefix := strings.TrimRight(pattern, "XY")
+	// wildcards are only at the end after at least 3 characters of the model series
+	if len(prefix) < 3 || prefix == pattern {
+		return pattern == model
+	}
+	return len(model) > len(prefix) && strings.HasPrefix(model, prefix)
+}
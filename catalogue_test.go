@@ -0,0 +1,118 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleLookupModel() {
+	model, ok := LookupModel("fmc130")
+	fmt.Println(model.Name, model.Family, ok)
+
+	// Vehicle Speed is read from LV-CAN which FMB920 does not have
+	humanDecoder := HumanDecoder{}
+	el := Element{Length: 1, IOID: 81, Value: []byte{0x32}}
+	if _, err := humanDecoder.Human(&el, "FMB920"); err != nil {
+		fmt.Println(err)
+	}
+	decoded, _ := humanDecoder.Human(&el, "FMB125")
+	fmt.Println(decoded.AvlEncodeKey.PropertyName)
+
+	// Output:
+	// FMC130 FMBXY true
+	// Element 81 Vehicle Speed is not supported by FMB920
+	// Vehicle Speed
+}
+
+func TestModelFamily(t *testing.T) {
+	tests := []struct {
+		model  string
+		family string
+		ok     bool
+	}{
+		{"FMB920", FamilyFMBXY, true},
+		{"FMC130", FamilyFMBXY, true},
+		{"FMM001", FamilyFMBXY, true},
+		{"FMB640", FamilyFM64, true},
+		{"FM3612", FamilyFM36, true},
+		{"FM1100", FamilyFM11XY, true},
+		{" fm1100 ", FamilyFM11XY, true},
+		{"XYZ100", "", false},
+	}
+	for _, tt := range tests {
+		family, ok := ModelFamily(tt.model)
+		if family != tt.family || ok != tt.ok {
+			t.Errorf("%q: want %v %v, got %v %v", tt.model, tt.family, tt.ok, family, ok)
+		}
+	}
+
+	// every model of the catalogue belongs to a built-in family
+	for _, m := range Models() {
+		if !isBuiltinFamily(m.Family) {
+			t.Errorf("%v: unknown family %v", m.Name, m.Family)
+		}
+	}
+}
+
+func TestHumanUnknownDevice(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+	el := Element{Length: 1, IOID: 1, Value: []byte{0x01}}
+
+	for _, device := range []string{"FMB999", "", "fmbxy"} {
+		if _, err := humanDecoder.Human(&el, device); err == nil || err.Error() != fmt.Sprintf("Unknown device %v", device) {
+			t.Errorf("%q: want Unknown device error, got %v", device, err)
+		}
+	}
+	for _, device := range []string{FamilyFMBXY, "FMB920", " fmb920 "} {
+		if _, err := humanDecoder.Human(&el, device); err != nil {
+			t.Errorf("%q: %v", device, err)
+		}
+	}
+}
+
+func TestSupports(t *testing.T) {
+	tests := []struct {
+		hwSupport string
+		model     Model
+		want      bool
+	}{
+		{"FMB100, FMB110, FMB120, FMB122, FMB125", Model{Name: "FMB125"}, true},
+		{"FMB100, FMB110, FMB120, FMB122, FMB125", Model{Name: "FMB920"}, false},
+		{"FMB100, FMB110, FMB120, FMB122, FMB125", Model{Name: "FMC130", Compatible: []string{"FMB130", "FMB120"}}, true},
+		{"FMB1XY", Model{Name: "FMB140"}, true},
+		{"FMB1XY", Model{Name: "FMB920"}, false},
+		{"FMBXY", Model{Name: "FMB920"}, true},
+		{"FM3612, FM36M1", Model{Name: "FM36M1"}, true},
+		{"", Model{Name: "FMB920"}, true},
+		{"All hardware with LSM6DSL gyroscope", Model{Name: "FMB920"}, true},
+		{"HW with gyro (LSM6DSL)", Model{Name: "FMB001"}, true},
+	}
+	for _, tt := range tests {
+		key := AvlEncodeKey{HWSupport: tt.hwSupport}
+		if got := key.Supports(tt.model); got != tt.want {
+			t.Errorf("%q supports %v: want %v, got %v", tt.hwSupport, tt.model.Name, tt.want, got)
+		}
+	}
+}
+
+func TestRegisterModel(t *testing.T) {
+	if err := RegisterModel(Model{Name: "fmc13a", Family: FamilyFMBXY, Compatible: []string{"FMB120"}}); err != nil {
+		t.Fatal(err)
+	}
+	if family, ok := ModelFamily("FMC13A"); !ok || family != FamilyFMBXY {
+		t.Errorf("want registered model, got %v %v", family, ok)
+	}
+	if err := RegisterModel(Model{Name: "FMC13B"}); err == nil {
+		t.Error("want missing family error")
+	}
+
+	humanDecoder := HumanDecoder{}
+	el := Element{Length: 1, IOID: 81, Value: []byte{0x32}}
+	if _, err := humanDecoder.Human(&el, "FMC13A"); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,417 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command teltonika-decode decodes Teltonika TCP frames and UDP packets and prints them in human readable format.
+//
+// Usage:
+//
+//	teltonika-decode [flags] [hex ...]
+//
+// Packets are taken from hex arguments, or from the file given by -in or stdin if there is no argument.
+// The input can be hex text with one packet per line or raw binary, a binary TCP stream is split into frames
+// and binary UDP datagrams by their length.
+// TCP or UDP framing is detected for every packet.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/JKWalrave/teltonikaparser"
+)
+
+// packet is the output representation of a decoded packet
+type packet struct {
+	Transport string
+	IMEI      string
+	CodecID   byte
+	NoOfData  uint8
+	Records   []record                     `json:",omitempty"`
+	Codec15   *teltonikaparser.Codec15Data `json:",omitempty"`
+	Raw       string                       `json:",omitempty"`
+}
+
+// record is the output representation of AVL data
+type record struct {
+	Time           time.Time
+	Priority       uint8
+	Lat            float64
+	Lng            float64
+	Altitude       int16
+	Angle          uint16
+	VisSat         uint8
+	Speed          uint16
+	EventID        uint16
+	GenerationType string `json:",omitempty"`
+	Elements       []element
+}
+
+// element is the output representation of IO element converted by HumanDecoder
+type element struct {
+	ID    uint16
+	Name  string          `json:",omitempty"`
+	Value interface{}     `json:",omitempty"`
+	Units string          `json:",omitempty"`
+	Label string          `json:",omitempty"`
+	Flags map[string]bool `json:",omitempty"`
+	Raw   string          `json:",omitempty"`
+}
+
+// options holds command line flags
+type options struct {
+	format string
+	family string
+	raw    bool
+	in     string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run executes the command and return exit code
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	flags := flag.NewFlagSet("teltonika-decode", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	opts := options{}
+	flags.StringVar(&opts.format, "format", "table", "output format [table, json, ndjson]")
+	flags.StringVar(&opts.family, "family", teltonikaparser.FamilyFMBXY, "device family [FMBXY, FM64, FM36, FM11XY] or model like FMB920 used for IO elements")
+	flags.BoolVar(&opts.raw, "raw", false, "show raw bytes of packets and IO elements alongside converted values")
+	flags.StringVar(&opts.in, "in", "", "read packets from file instead of stdin, hex text or binary")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: teltonika-decode [flags] [hex ...]\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if opts.format != "table" && opts.format != "json" && opts.format != "ndjson" {
+		fmt.Fprintf(stderr, "invalid format %q, want table, json or ndjson\n", opts.format)
+		return 2
+	}
+
+	var packets [][]byte
+	var err error
+	if flags.NArg() > 0 {
+		packets, err = readHexArgs(flags.Args())
+	} else {
+		input := stdin
+		if opts.in != "" {
+			f, err := os.Open(opts.in)
+			if err != nil {
+				fmt.Fprintln(stderr, err)
+				return 1
+			}
+			defer f.Close()
+			input = f
+		}
+		packets, err = readInput(input)
+	}
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+
+	out := bufio.NewWriter(stdout)
+
+	exitCode := 0
+	decoded := make([]packet, 0, len(packets))
+	humanDecoder := teltonikaparser.NewHumanDecoder()
+	for i, bs := range packets {
+		p, err := decode(bs, humanDecoder, opts)
+		if err != nil {
+			fmt.Fprintf(stderr, "packet %v: %v\n", i, err)
+			exitCode = 1
+			continue
+		}
+
+		switch opts.format {
+		case "ndjson":
+			line, err := json.Marshal(p)
+			if err != nil {
+				fmt.Fprintf(stderr, "packet %v: %v\n", i, err)
+				exitCode = 1
+				continue
+			}
+			out.Write(append(line, '\n'))
+		case "table":
+			printTable(out, p)
+		default:
+			decoded = append(decoded, p)
+		}
+	}
+
+	if opts.format == "json" {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(decoded); err != nil {
+			fmt.Fprintln(stderr, err)
+			exitCode = 1
+		}
+	}
+
+	// write errors of bufio.Writer are sticky and reported by Flush
+	if err := out.Flush(); err != nil {
+		fmt.Fprintln(stderr, err)
+		exitCode = 1
+	}
+	return exitCode
+}
+
+// readHexArgs decodes packets given as hex arguments
+func readHexArgs(args []string) ([][]byte, error) {
+	packets := make([][]byte, 0, len(args))
+	for _, arg := range args {
+		bs, err := hex.DecodeString(strings.Join(strings.Fields(arg), ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex argument, %v", err)
+		}
+		packets = append(packets, bs)
+	}
+	return packets, nil
+}
+
+// readInput reads packets from hex text with one packet per line or from raw binary, blank lines are skipped.
+// Binary starting with four zero bytes preamble or IMEI login packet is split into TCP frames,
+// otherwise it is split into UDP packets by their 2 Bytes length
+func readInput(r io.Reader) ([][]byte, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if isHexText(data) {
+		var lines []string
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				lines = append(lines, line)
+			}
+		}
+		return readHexArgs(lines)
+	}
+
+	if len(data) < 4 || (!bytes.HasPrefix(data, []byte{0x00, 0x00, 0x00, 0x00}) && !bytes.HasPrefix(data, []byte{0x00, 0x0F})) {
+		return splitUDP(data)
+	}
+
+	var packets [][]byte
+	reader := teltonikaparser.NewFrameReader(bytes.NewReader(data), len(data))
+	if bytes.HasPrefix(data, []byte{0x00, 0x0F}) {
+		login, err := reader.ReadIMEIPacket()
+		if err != nil {
+			return nil, fmt.Errorf("reading IMEI login packet, %v", err)
+		}
+		packets = append(packets, login)
+	}
+	for {
+		frame, err := reader.ReadFrame()
+		if errors.Is(err, io.EOF) {
+			return packets, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading TCP frame %v, %v", len(packets), err)
+		}
+		packets = append(packets, frame)
+	}
+}
+
+// splitUDP splits binary data into UDP packets, every packet starts with 2 Bytes length of the rest of the packet
+func splitUDP(data []byte) ([][]byte, error) {
+	var packets [][]byte
+	for len(data) > 0 {
+		if len(data) < 2 {
+			return nil, fmt.Errorf("reading UDP packet %v, 1 trailing Byte", len(packets))
+		}
+		size := 2 + int(binary.BigEndian.Uint16(data))
+		if size > len(data) {
+			return nil, fmt.Errorf("reading UDP packet %v, declared length %v Bytes, %v Bytes left", len(packets), size-2, len(data)-2)
+		}
+		packets = append(packets, data[:size])
+		data = data[size:]
+	}
+	return packets, nil
+}
+
+// isHexText reports whether data contains only hex digits and white spaces
+func isHexText(data []byte) bool {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return false
+	}
+	for _, b := range data {
+		switch {
+		case b >= '0' && b <= '9', b >= 'a' && b <= 'f', b >= 'A' && b <= 'F':
+		case b == ' ', b == '\t', b == '\r', b == '\n':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// decode detects framing of a packet, decodes it and converts IO elements by HumanDecoder
+func decode(bs []byte, h *teltonikaparser.HumanDecoder, opts options) (packet, error) {
+	p := packet{}
+	if opts.raw {
+		p.Raw = hex.EncodeToString(bs)
+	}
+
+	if teltonikaparser.IsIMEIPacket(&bs) {
+		imei, err := teltonikaparser.DecodeIMEI(&bs)
+		if err != nil {
+			return packet{}, err
+		}
+		p.Transport = "IMEI login"
+		p.IMEI = imei
+		return p, nil
+	}
+
+	var decoded teltonikaparser.Decoded
+	var err error
+	if bytes.HasPrefix(bs, []byte{0x00, 0x00, 0x00, 0x00}) {
+		decoded, err = teltonikaparser.DecodeTCP(&bs)
+	} else {
+		decoded, err = teltonikaparser.DecodeUDP(&bs)
+	}
+	if err != nil {
+		return packet{}, err
+	}
+
+	p.Transport = decoded.Transport.String()
+	p.IMEI = decoded.IMEI
+	p.CodecID = decoded.CodecID
+	p.NoOfData = decoded.NoOfData
+	p.Codec15 = decoded.Codec15
+
+	for _, avl := range decoded.Data {
+		r := record{
+			Time:     time.Unix(0, int64(avl.UtimeMs)*int64(time.Millisecond)).UTC(),
+			Priority: avl.Priority,
+			Lat:      float64(avl.Lat) / 10000000,
+			Lng:      float64(avl.Lng) / 10000000,
+			Altitude: avl.Altitude,
+			Angle:    avl.Angle,
+			VisSat:   avl.VisSat,
+			Speed:    avl.Speed,
+			EventID:  avl.EventID,
+			Elements: make([]element, 0, len(avl.Elements)),
+		}
+		if decoded.CodecID == 0x10 {
+			r.GenerationType = avl.GenerationType.String()
+		}
+
+		for i := range avl.Elements {
+			el := &avl.Elements[i]
+			e := element{ID: el.IOID}
+			if opts.raw {
+				e.Raw = hex.EncodeToString(el.Value)
+			}
+
+			// unknown elements are printed without a name, as raw bytes
+			if havl, err := h.Human(el, opts.family); err == nil {
+				e.Name = havl.AvlEncodeKey.PropertyName
+				e.Units = havl.Units()
+				if val, err := havl.ScaledValue(); err == nil {
+					e.Value = jsonValue(val)
+				}
+				if label, err := havl.Label(); err == nil {
+					e.Label = label
+				}
+				if flags, err := havl.Flags(); err == nil {
+					e.Flags = flags
+				}
+			}
+			if e.Value == nil && e.Raw == "" {
+				e.Raw = hex.EncodeToString(el.Value)
+			}
+			r.Elements = append(r.Elements, e)
+		}
+		p.Records = append(p.Records, r)
+	}
+
+	return p, nil
+}
+
+// jsonValue returns a typed value for the output, binary values are printed as raw bytes instead
+func jsonValue(val teltonikaparser.Value) interface{} {
+	switch val.Kind() {
+	case teltonikaparser.KindBool:
+		return val.Bool()
+	case teltonikaparser.KindInt:
+		return val.Int64()
+	case teltonikaparser.KindUint:
+		return val.Uint64()
+	case teltonikaparser.KindFloat:
+		return val.Float64()
+	case teltonikaparser.KindString:
+		return val.String()
+	}
+	return nil
+}
+
+// setFlags returns sorted names of flags which are set
+func setFlags(flags map[string]bool) []string {
+	set := make([]string, 0, len(flags))
+	for name, v := range flags {
+		if v {
+			set = append(set, name)
+		}
+	}
+	sort.Strings(set)
+	return set
+}
+
+// printTable prints a packet as aligned text
+func printTable(w io.Writer, p packet) {
+	fmt.Fprint(w, p.Transport)
+	if p.IMEI != "" {
+		fmt.Fprintf(w, " IMEI %v", p.IMEI)
+	}
+	if p.Transport != "IMEI login" {
+		fmt.Fprintf(w, " Codec 0x%02X, %v records", p.CodecID, p.NoOfData)
+	}
+	fmt.Fprintln(w)
+	if p.Raw != "" {
+		fmt.Fprintf(w, "  raw %v\n", p.Raw)
+	}
+
+	if p.Codec15 != nil {
+		fmt.Fprintf(w, "  %v payload %x\n", time.Unix(int64(p.Codec15.Utime), 0).UTC().Format(time.RFC3339), p.Codec15.Payload)
+	}
+
+	for i, r := range p.Records {
+		fmt.Fprintf(w, "  #%v %v lat %v lng %v alt %v angle %v sat %v speed %v event %v", i, r.Time.Format(time.RFC3339), r.Lat, r.Lng, r.Altitude, r.Angle, r.VisSat, r.Speed, r.EventID)
+		if r.GenerationType != "" {
+			fmt.Fprintf(w, " generation %v", r.GenerationType)
+		}
+		fmt.Fprintln(w)
+
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		for _, e := range r.Elements {
+			value := ""
+			if e.Value != nil {
+				value = strings.TrimSpace(fmt.Sprintf("%v %v", e.Value, e.Units))
+			}
+			if e.Label != "" {
+				value = fmt.Sprintf("%v (%v)", value, e.Label)
+			}
+			if set := setFlags(e.Flags); len(set) > 0 {
+				value = fmt.Sprintf("%v [%v]", value, strings.Join(set, ", "))
+			}
+			fmt.Fprintf(tw, "    %v\t%v\t%v\t%v\n", e.ID, e.Name, value, e.Raw)
+		}
+		tw.Flush()
+	}
+}
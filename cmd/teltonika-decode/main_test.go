@@ -0,0 +1,111 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tcp := "000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF"
+	udp := "005FCAFE0107000F3335323039333038353639383230360801000001669BF6FAB80000000000000000000000000000000000020200EF00F000000001"
+
+	var stdout, stderr bytes.Buffer
+	stdin := strings.NewReader(tcp + "\n" + udp + "\n")
+	if code := run([]string{"-format", "ndjson", "-raw"}, stdin, &stdout, &stderr); code != 0 {
+		t.Fatalf("want exit code 0, got %v, %v", code, stderr.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("want 2 lines, got %v", len(lines))
+	}
+
+	want := []string{"TCP", "UDP"}
+	for i, line := range lines {
+		p := packet{}
+		if err := json.Unmarshal([]byte(line), &p); err != nil {
+			t.Fatal(err)
+		}
+		if p.Transport != want[i] || len(p.Records) != 1 || p.Raw == "" {
+			t.Errorf("line %v: unexpected packet %+v", i, p)
+		}
+	}
+
+	// GSM Signal of the TCP frame is converted by HumanDecoder
+	if !strings.Contains(lines[0], `{"ID":21,"Name":"GSM Signal","Value":3,"Raw":"03"}`) {
+		t.Errorf("want converted GSM Signal, got %v", lines[0])
+	}
+
+	stdout.Reset()
+	if code := run([]string{"00"}, nil, &stdout, &stderr); code != 1 {
+		t.Errorf("want exit code 1 for invalid packet, got %v", code)
+	}
+
+	stdout.Reset()
+	if code := run([]string{"-format", "table", tcp}, nil, &stdout, &stderr); code != 0 {
+		t.Fatalf("want exit code 0, got %v, %v", code, stderr.String())
+	}
+	if !strings.HasPrefix(stdout.String(), "TCP Codec 0x08, 1 records\n") {
+		t.Errorf("want codec 0x08 in table, got %v", stdout.String())
+	}
+
+	// write errors are reported by the exit code
+	for _, format := range []string{"table", "json", "ndjson"} {
+		stderr.Reset()
+		if code := run([]string{"-format", format, tcp}, nil, failingWriter{}, &stderr); code != 1 || stderr.Len() == 0 {
+			t.Errorf("%v: want exit code 1 and error for failing output, got %v %q", format, code, stderr.String())
+		}
+	}
+}
+
+func TestReadInput(t *testing.T) {
+	tcp := "000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF"
+	udp := "003ACAFE0107000F3335323039333038353639383230360801000001669BF6FAB80000000000000000000000000000000000020200EF00F000000001"
+	login := "000F333536333037303432343431303133"
+
+	tests := []struct {
+		name    string
+		input   string
+		packets int
+		err     bool
+	}{
+		{"blank lines", tcp + "\n\n" + udp + "\n\n", 2, false},
+		{"CRLF", tcp + "\r\n" + udp + "\r\n", 2, false},
+		{"binary TCP", fromHex(login + tcp + tcp), 3, false},
+		{"binary UDP", fromHex(udp + udp), 2, false},
+		{"binary UDP with trailing Bytes", fromHex(udp + "0010CAFE"), 0, true},
+		{"binary TCP with trailing Bytes", fromHex(tcp + "00000000"), 0, true},
+	}
+	for _, tt := range tests {
+		packets, err := readInput(strings.NewReader(tt.input))
+		if (err != nil) != tt.err || len(packets) != tt.packets {
+			t.Errorf("%v: want %v packets (error %v), got %v, %v", tt.name, tt.packets, tt.err, len(packets), err)
+		}
+	}
+
+	// blank line does not fail the run
+	var stdout, stderr bytes.Buffer
+	if code := run(nil, strings.NewReader(tcp+"\r\n\r\n"+udp+"\r\n"), &stdout, &stderr); code != 0 {
+		t.Errorf("want exit code 0, got %v, %v", code, stderr.String())
+	}
+}
+
+func fromHex(s string) string {
+	bs, _ := hex.DecodeString(s)
+	return string(bs)
+}
+
+// failingWriter fails every write
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("write failed")
+}
@@ -0,0 +1,56 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"github.com/filipkroca/b2n"
+)
+
+// codec15MessageType is the message type of Codec 15 frames
+const codec15MessageType byte = 0x0B
+
+// Codec15Data represents data forwarded by FMX6 devices from RS232 connected peripherals (Garmin, ...)
+// https://wiki.teltonika.lt/view/Codec#Codec_15
+type Codec15Data struct {
+	Utime   uint32 // Timestamp in seconds
+	IMEI    string // IMEI of the device
+	Payload []byte // Raw data of the peripheral
+}
+
+// decodeCodec15 takes a pointer to a slice of bytes with Codec 15 frame and return Decoded with filled Codec15
+// Codec ID | Message Quantity 1 | Type 0x0B | Message Size | Timestamp | IMEI | Payload | Message Quantity 2
+func decodeCodec15(bs *[]byte) (Decoded, error) {
+	cmd, message, err := decodeCommandFrame(bs, 0x0F)
+	if err != nil {
+		return Decoded{}, err
+	}
+	if cmd.Type != codec15MessageType {
+		return Decoded{}, decodeError(10, FieldCommandType, ErrInvalidCommand, "codec 15 message Type, want 0x0B, got %#x", cmd.Type)
+	}
+
+	// message size includes 4 Bytes of timestamp and 8 Bytes of IMEI
+	if len(message) < 12 {
+		return Decoded{}, decodeError(11, FieldCommandSize, ErrDataLength, "codec 15 message size has to include 4 Bytes of timestamp and 8 Bytes of IMEI, got %v", len(message))
+	}
+
+	data := Codec15Data{}
+	data.Utime, err = b2n.ParseBs2Uint32(&message, 0)
+	if err != nil {
+		return Decoded{}, unexpectedEnd(bs, 15, FieldTimestamp, 4)
+	}
+	data.IMEI, err = decodeCommandIMEI(message[4:12])
+	if err != nil {
+		return Decoded{}, decodeError(19, FieldIMEI, ErrInvalidIMEI, "%v", err)
+	}
+	data.Payload = message[12:]
+
+	return Decoded{
+		IMEI:      data.IMEI,
+		CodecID:   cmd.CodecID,
+		NoOfData:  1,
+		Transport: TransportTCP,
+		Codec15:   &data,
+	}, nil
+}
@@ -0,0 +1,243 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/filipkroca/b2n"
+)
+
+// Types of GPRS command messages
+const (
+	CommandTypeCommand  byte = 0x05 // command sent by a server, codec 13 message sent by a device
+	CommandTypeResponse byte = 0x06 // response sent by a device, codec 14 ACK
+	CommandTypeNACK     byte = 0x11 // codec 14 nACK, the device rejected the command because IMEI did not match
+)
+
+// Command represents a GPRS command sent to a device or a response of the device
+// https://wiki.teltonika.lt/view/Codec#Codec_12
+// https://wiki.teltonika.lt/view/Codec#Codec_13
+// https://wiki.teltonika.lt/view/Codec#Codec_14
+type Command struct {
+	CodecID byte   // 0x0C (codec 12), 0x0D (codec 13) or 0x0E (codec 14)
+	Type    byte   // 0x05 command, 0x06 response, 0x11 nACK
+	Text    string // Command or response text
+	Utime   uint32 // Timestamp in seconds, only codec 13
+	IMEI    string // IMEI of the target device, only codec 14
+}
+
+// Rejected reports whether the device rejected a codec 14 command because IMEI did not match
+func (c *Command) Rejected() bool {
+	return c.CodecID == 0x0E && c.Type == CommandTypeNACK
+}
+
+// EncodeCodec12 takes a command like "getinfo" or "setdigout 1" and return Codec 12 frame which should be sent to a device over its TCP session
+func EncodeCodec12(command string) []byte {
+	return encodeTCPFrame(encodeCommandData(0x0C, CommandTypeCommand, []byte(command)))
+}
+
+// DecodeCodec12 takes a pointer to a slice of bytes with Codec 12 frame and return Command,
+// the device response has Type 0x06 and Text holds the response text
+func DecodeCodec12(bs *[]byte) (Command, error) {
+	cmd, payload, err := decodeCommandFrame(bs, 0x0C)
+	if err != nil {
+		return Command{}, err
+	}
+	if cmd.Type != CommandTypeCommand && cmd.Type != CommandTypeResponse {
+		return Command{}, decodeError(10, FieldCommandType, ErrInvalidCommand, "want 0x05 or 0x06, got %#x", cmd.Type)
+	}
+
+	cmd.Text = string(payload)
+	return cmd, nil
+}
+
+// EncodeCodec13 takes a command text and a timestamp in seconds and return Codec 13 frame,
+// devices send Codec 13 messages when the message timestamp is enabled, it is useful for simulation of devices
+func EncodeCodec13(command string, utime uint32) []byte {
+	payload := make([]byte, 4, 4+len(command))
+	payload[0], payload[1], payload[2], payload[3] = byte(utime>>24), byte(utime>>16), byte(utime>>8), byte(utime)
+	payload = append(payload, command...)
+	return encodeTCPFrame(encodeCommandData(0x0D, CommandTypeCommand, payload))
+}
+
+// DecodeCodec13 takes a pointer to a slice of bytes with Codec 13 frame and return Command with the timestamp and the text
+func DecodeCodec13(bs *[]byte) (Command, error) {
+	cmd, payload, err := decodeCommandFrame(bs, 0x0D)
+	if err != nil {
+		return Command{}, err
+	}
+	if cmd.Type != CommandTypeCommand {
+		return Command{}, decodeError(10, FieldCommandType, ErrInvalidCommand, "want 0x05, got %#x", cmd.Type)
+	}
+
+	// command size includes 4 Bytes of timestamp
+	if len(payload) < 4 {
+		return Command{}, decodeError(11, FieldCommandSize, ErrDataLength, "codec 13 command size has to include 4 Bytes of timestamp, got %v", len(payload))
+	}
+	cmd.Utime, err = b2n.ParseBs2Uint32(&payload, 0)
+	if err != nil {
+		return Command{}, unexpectedEnd(bs, 15, FieldTimestamp, 4)
+	}
+
+	cmd.Text = string(payload[4:])
+	return cmd, nil
+}
+
+// EncodeCodec14 takes a command text and IMEI of the target device and return Codec 14 frame,
+// the device executes the command only if IMEI matches, otherwise it answers by nACK
+func EncodeCodec14(command string, imei string) ([]byte, error) {
+	encodedIMEI, err := encodeCommandIMEI(imei)
+	if err != nil {
+		return nil, err
+	}
+	payload := append(encodedIMEI, command...)
+	return encodeTCPFrame(encodeCommandData(0x0E, CommandTypeCommand, payload)), nil
+}
+
+// DecodeCodec14 takes a pointer to a slice of bytes with Codec 14 frame and return Command with the target IMEI,
+// Type is 0x05 for a command, 0x06 for ACK with the response text and 0x11 for nACK
+func DecodeCodec14(bs *[]byte) (Command, error) {
+	cmd, payload, err := decodeCommandFrame(bs, 0x0E)
+	if err != nil {
+		return Command{}, err
+	}
+	if cmd.Type != CommandTypeCommand && cmd.Type != CommandTypeResponse && cmd.Type != CommandTypeNACK {
+		return Command{}, decodeError(10, FieldCommandType, ErrInvalidCommand, "want 0x05, 0x06 or 0x11, got %#x", cmd.Type)
+	}
+
+	// command size includes 8 Bytes of IMEI
+	if len(payload) < 8 {
+		return Command{}, decodeError(11, FieldCommandSize, ErrDataLength, "codec 14 command size has to include 8 Bytes of IMEI, got %v", len(payload))
+	}
+	cmd.IMEI, err = decodeCommandIMEI(payload[0:8])
+	if err != nil {
+		return Command{}, decodeError(15, FieldIMEI, ErrInvalidIMEI, "%v", err)
+	}
+
+	cmd.Text = string(payload[8:])
+	return cmd, nil
+}
+
+// EncodeCommand return a frame of cmd encoded by the codec of cmd.CodecID, zero CodecID means codec 12
+func EncodeCommand(cmd Command) ([]byte, error) {
+	switch cmd.CodecID {
+	case 0, 0x0C:
+		return EncodeCodec12(cmd.Text), nil
+	case 0x0D:
+		return EncodeCodec13(cmd.Text, cmd.Utime), nil
+	case 0x0E:
+		return EncodeCodec14(cmd.Text, cmd.IMEI)
+	}
+	return nil, fmt.Errorf("Unable to encode command, invalid Codec ID %#x", cmd.CodecID)
+}
+
+// DecodeCommand takes a pointer to a slice of bytes with a command frame and decodes it by DecodeCodec12, DecodeCodec13
+// or DecodeCodec14 according to its Codec ID
+func DecodeCommand(bs *[]byte) (Command, error) {
+	if len(*bs) < 9 {
+		return Command{}, unexpectedEnd(bs, 8, FieldCodecID, 1)
+	}
+	switch (*bs)[8] {
+	case 0x0C:
+		return DecodeCodec12(bs)
+	case 0x0D:
+		return DecodeCodec13(bs)
+	case 0x0E:
+		return DecodeCodec14(bs)
+	}
+	return Command{}, decodeError(8, FieldCodecID, ErrInvalidCodec, "want 0x0C, 0x0D or 0x0E, got %#x", (*bs)[8])
+}
+
+// isCommandFrame reports whether a TCP frame carries a command of codec 12, 13 or 14 instead of AVL data
+func isCommandFrame(frame []byte) bool {
+	return len(frame) > 8 && frame[8] >= 0x0C && frame[8] <= 0x0E
+}
+
+// encodeCommandIMEI encodes IMEI into 8 Bytes as HEX digits, IMEI 352093081452251 is encoded as 0x0352093081452251
+func encodeCommandIMEI(imei string) ([]byte, error) {
+	if len(imei) != 15 && len(imei) != 16 {
+		return nil, fmt.Errorf("Invalid IMEI length, want 15 or 16, got %v", len(imei))
+	}
+	for _, c := range imei {
+		if c < '0' || c > '9' {
+			return nil, fmt.Errorf("Invalid IMEI %v, want only digits", imei)
+		}
+	}
+	if len(imei) == 15 {
+		imei = "0" + imei
+	}
+	return hex.DecodeString(imei)
+}
+
+// decodeCommandIMEI decodes IMEI encoded into 8 Bytes as HEX digits
+func decodeCommandIMEI(bs []byte) (string, error) {
+	imei := hex.EncodeToString(bs)
+	if strings.Trim(imei, "0123456789") != "" {
+		return "", fmt.Errorf("Invalid IMEI %#x, want only decimal digits", bs)
+	}
+	if imei[0] == '0' {
+		imei = imei[1:]
+	}
+	return imei, nil
+}
+
+// encodeCommandData creates the data field of a command frame
+// Codec ID | Command Quantity 1 | Type | Command Size | Command | Command Quantity 2
+func encodeCommandData(codecID byte, cmdType byte, payload []byte) []byte {
+	size := len(payload)
+	data := make([]byte, 0, 8+size)
+	data = append(data, codecID, 0x01, cmdType, byte(size>>24), byte(size>>16), byte(size>>8), byte(size))
+	data = append(data, payload...)
+	return append(data, 0x01)
+}
+
+// decodeCommandFrame validates a command frame and return Command with filled CodecID and Type, and the payload of the frame
+func decodeCommandFrame(bs *[]byte, codecID byte) (Command, []byte, error) {
+	cmd := Command{}
+
+	// validate preamble, data field length and CRC
+	dataLen, err := checkTCPFrame(bs)
+	if err != nil {
+		return Command{}, nil, err
+	}
+
+	// Codec ID, Command Quantity 1, Type, Command Size and Command Quantity 2 take 8 Bytes
+	if dataLen < 8 {
+		return Command{}, nil, decodeError(4, FieldDataLength, ErrDataLength, "minimum data field length of command is 8 Bytes, got %v", dataLen)
+	}
+
+	// decode Codec ID
+	cmd.CodecID = (*bs)[8]
+	if cmd.CodecID != codecID {
+		return Command{}, nil, decodeError(8, FieldCodecID, ErrInvalidCodec, "want %#x, got %#x", codecID, cmd.CodecID)
+	}
+
+	// one frame carries exactly one command
+	quantity := (*bs)[9]
+	if quantity != 0x01 {
+		return Command{}, nil, decodeError(9, FieldQuantity, ErrInvalidCommand, "want 1, got %v", quantity)
+	}
+
+	cmd.Type = (*bs)[10]
+
+	// command size has to fill the data field
+	size, err := b2n.ParseBs2Uint32(bs, 11)
+	if err != nil {
+		return Command{}, nil, unexpectedEnd(bs, 11, FieldCommandSize, 4)
+	}
+	if int64(size)+8 != int64(dataLen) {
+		return Command{}, nil, decodeError(11, FieldCommandSize, ErrDataLength, "declared command size %v Bytes, data field length %v Bytes", size, dataLen)
+	}
+
+	end := 15 + int(size)
+	if (*bs)[end] != quantity {
+		return Command{}, nil, decodeError(end, FieldQuantity, ErrCountMismatch, "Command Quantity 2, want %v, got %v", quantity, (*bs)[end])
+	}
+
+	return cmd, (*bs)[15:end], nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"testing"
+)
+
+func ExampleEncodeCodec12() {
+	fmt.Printf("%X\n", EncodeCodec12("getinfo"))
+
+	// Output:
+	// 000000000000000F0C010500000007676574696E666F0100004312
+}
+
+func ExampleDecodeCodec12() {
+	// response of a device to the command getinfo
+	stringData := `00000000000000900C010600000088494E493A323031392F372F323220373A3232205254433A323031392F372F323220373A3533205253543A32204552523A312053523A302042523A302043463A302046473A3020464C3A302054553A302F302055543A3020534D533A30204E4F4750533A303A3330204750533A31205341543A302052533A332052463A36352053463A31204D443A30010000C78F`
+
+	bs, _ := hex.DecodeString(stringData)
+	response, err := DecodeCodec12(&bs)
+	if err != nil {
+		log.Panicf("Error when decoding a bs, %v\n", err)
+	}
+	fmt.Printf("Type: %#x, Response: %v\n", response.Type, response.Text)
+
+	// Output:
+	// Type: 0x6, Response: INI:2019/7/22 7:22 RTC:2019/7/22 7:53 RST:2 ERR:1 SR:0 BR:0 CF:0 FG:0 FL:0 TU:0/0 UT:0 SMS:0 NOGPS:0:30 GPS:1 SAT:0 RS:3 RF:65 SF:1 MD:0
+}
+
+func TestDecodeCodec12(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want error
+		text string
+	}{
+		{"getver command", "000000000000000E0C010500000006676574766572010000A4C2", nil, "getver"},
+		{"corrupted crc", "000000000000000E0C010500000006676574766572010000A4C3", ErrCRC, ""},
+		{"command size", "000000000000000E0C0105000000076765747665720100006803", ErrDataLength, ""},
+	}
+
+	for _, tt := range tests {
+		bs, _ := hex.DecodeString(tt.data)
+		cmd, err := DecodeCodec12(&bs)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%v: want error %v, got %v", tt.name, tt.want, err)
+		}
+		if cmd.Text != tt.text {
+			t.Errorf("%v: want text %q, got %q", tt.name, tt.text, cmd.Text)
+		}
+	}
+
+	// encoded commands are decoded back
+	for _, text := range []string{"getinfo", "setdigout 1", "cpureset", ""} {
+		bs := EncodeCodec12(text)
+		cmd, err := DecodeCodec12(&bs)
+		if err != nil || cmd.Type != CommandTypeCommand || cmd.Text != text {
+			t.Errorf("%q: unexpected decoded command %+v, error %v", text, cmd, err)
+		}
+	}
+}
+
+func ExampleEncodeCodec14() {
+	bs, err := EncodeCodec14("getver", "352093081452251")
+	if err != nil {
+		log.Panicf("Error when encoding a command, %v\n", err)
+	}
+	fmt.Printf("%X\n", bs)
+
+	// Output:
+	// 00000000000000160E01050000000E0352093081452251676574766572010000D2C1
+}
+
+func ExampleDecodeCodec14() {
+	// nACK, the device has a different IMEI than the command was sent to
+	stringData := `00000000000000100E011100000008035209308145225101000032AC`
+
+	bs, _ := hex.DecodeString(stringData)
+	response, err := DecodeCodec14(&bs)
+	if err != nil {
+		log.Panicf("Error when decoding a bs, %v\n", err)
+	}
+	fmt.Printf("IMEI: %v, Rejected: %v\n", response.IMEI, response.Rejected())
+
+	// Output:
+	// IMEI: 352093081452251, Rejected: true
+}
+
+func TestCodec13(t *testing.T) {
+	bs := EncodeCodec13("getinfo", 1563801730)
+	cmd, err := DecodeCodec13(&bs)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if cmd.CodecID != 0x0D || cmd.Type != CommandTypeCommand || cmd.Utime != 1563801730 || cmd.Text != "getinfo" {
+		t.Errorf("unexpected decoded command %+v", cmd)
+	}
+
+	// codec 13 frame is not a valid codec 12 frame
+	if _, err := DecodeCodec12(&bs); err == nil {
+		t.Error("want error when decoding codec 13 frame by DecodeCodec12")
+	}
+}
+
+func TestCodec14(t *testing.T) {
+	if _, err := EncodeCodec14("getver", "35209308145225"); err == nil {
+		t.Error("want error for short IMEI")
+	}
+	if _, err := EncodeCodec14("getver", "35209308145225A"); err == nil {
+		t.Error("want error for IMEI with letters")
+	}
+
+	bs, err := EncodeCodec14("setdigout 1", "352093081452251")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// the device answers with ACK to the same frame layout
+	bs[10] = CommandTypeResponse
+	crc := crc16IBM(bs[8 : len(bs)-4])
+	bs[len(bs)-2], bs[len(bs)-1] = byte(crc>>8), byte(crc)
+
+	cmd, err := DecodeCodec14(&bs)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if cmd.IMEI != "352093081452251" || cmd.Text != "setdigout 1" || cmd.Type != CommandTypeResponse || cmd.Rejected() {
+		t.Errorf("unexpected decoded command %+v", cmd)
+	}
+}
+
+// testCommands are commands of all command codecs, they are used by tests and as the seed corpus of fuzz tests
+var testCommands = []Command{
+	{CodecID: 0x0C, Type: CommandTypeCommand, Text: "getinfo"},
+	{CodecID: 0x0D, Type: CommandTypeCommand, Text: "getver", Utime: 1563780720},
+	{CodecID: 0x0E, Type: CommandTypeCommand, Text: "setdigout 1", IMEI: "352093081452251"},
+}
+
+func TestCommandRoundTrip(t *testing.T) {
+	for _, cmd := range testCommands {
+		bs, err := EncodeCommand(cmd)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		decoded, err := DecodeCommand(&bs)
+		if err != nil || decoded != cmd {
+			t.Errorf("want %+v, got %+v, %v", cmd, decoded, err)
+		}
+	}
+
+	// zero Codec ID means codec 12
+	if bs, _ := EncodeCommand(Command{Text: "getinfo"}); fmt.Sprintf("%X", bs) != "000000000000000F0C010500000007676574696E666F0100004312" {
+		t.Errorf("want codec 12 frame, got %X", bs)
+	}
+	if _, err := EncodeCommand(Command{CodecID: 0x08, Text: "getinfo"}); err == nil {
+		t.Error("want error for codec 8 command")
+	}
+
+	bs, _ := hex.DecodeString("000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF")
+	if _, err := DecodeCommand(&bs); !errors.Is(err, ErrInvalidCodec) {
+		t.Errorf("want %v for AVL frame, got %v", ErrInvalidCodec, err)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// run with go test -race
+func TestHumanDecoderConcurrent(t *testing.T) {
+	fmb := decodeDetectPacket(t, detectFMBPacket)
+	fm11 := decodeDetectPacket(t, detectFM11Packet)
+	registry := NewRegistry()
+	registry.Set(fmb.IMEI, DeviceProfile{Model: "FMB920"})
+
+	for _, name := range []string{"zero value", "NewHumanDecoder"} {
+		humanDecoder := &HumanDecoder{}
+		if name == "NewHumanDecoder" {
+			humanDecoder = NewHumanDecoder()
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				for j := 0; j < 20; j++ {
+					for _, avl := range fmb.Data {
+						for k := range avl.Elements {
+							if decoded, err := humanDecoder.Human(&avl.Elements[k], FamilyFMBXY); err == nil {
+								decoded.GetFinalValue()
+							}
+						}
+					}
+					if _, err := humanDecoder.DecodedToHuman(&fmb, registry); err != nil {
+						t.Error(err)
+					}
+					if _, err := humanDecoder.DetectIMEIFamily(fm11.IMEI, &fm11.Data); err != nil {
+						t.Error(err)
+					}
+					humanDecoder.CachedFamily(fm11.IMEI)
+					if _, err := humanDecoder.AvlDataToHuman(&fm11.Data); err != nil {
+						t.Error(err)
+					}
+
+					// dictionaries are loaded while other goroutines decode
+					if j%5 == 0 {
+						dictionary := fmt.Sprintf(`{"%v": {"PropertyName": "Custom %v", "Bytes": "1", "Type": "Unsigned", "FinalConversion": "toUint8"}}`, 11000+i, i)
+						if err := humanDecoder.LoadJSON(FamilyFMBXY, strings.NewReader(dictionary)); err != nil {
+							t.Error(err)
+						}
+					}
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		// loads from all goroutines are kept
+		for i := 0; i < 8; i++ {
+			el := Element{Length: 1, IOID: uint16(11000 + i), Value: []byte{0x01}}
+			if _, err := humanDecoder.Human(&el, FamilyFMBXY); err != nil {
+				t.Errorf("%v: %v", name, err)
+			}
+		}
+	}
+}
+
+func TestBuiltinShared(t *testing.T) {
+	first, second := NewHumanDecoder(), &HumanDecoder{}
+	if reflect.ValueOf(first.dictionaries()).Pointer() != reflect.ValueOf(second.dictionaries()).Pointer() {
+		t.Fatal("want built-in dictionaries shared by decoders")
+	}
+
+	// loaded dictionaries do not change the shared ones
+	dictionary := `{"21": {"PropertyName": "Signal", "Bytes": "1", "Type": "Unsigned", "FinalConversion": "toUint8"}}`
+	if err := first.LoadJSON(FamilyFMBXY, strings.NewReader(dictionary)); err != nil {
+		t.Fatal(err)
+	}
+	el := Element{Length: 1, IOID: 21, Value: []byte{0x03}}
+	for _, tt := range []struct {
+		decoder *HumanDecoder
+		want    string
+	}{
+		{first, "Signal"},
+		{second, "GSM Signal"},
+		{NewHumanDecoder(), "GSM Signal"},
+	} {
+		decoded, err := tt.decoder.Human(&el, FamilyFMBXY)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded.AvlEncodeKey.PropertyName != tt.want {
+			t.Errorf("want %v, got %v", tt.want, decoded.AvlEncodeKey.PropertyName)
+		}
+	}
+}
+
+func TestHumanKeyCopy(t *testing.T) {
+	// Ignition of FMBXY has Values, Door Status has Bits
+	ignition := Element{Length: 1, IOID: 239, Value: []byte{0x01}}
+	doors := Element{Length: 2, IOID: 90, Value: []byte{0x21, 0x00}}
+
+	decoded, err := NewHumanDecoder().Human(&ignition, FamilyFMBXY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded.AvlEncodeKey.Values["1"] = "Changed"
+	decoded, err = NewHumanDecoder().Human(&doors, FamilyFMBXY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for bit := range decoded.AvlEncodeKey.Bits {
+		decoded.AvlEncodeKey.Bits[bit] = "Changed"
+	}
+
+	// other decoders still see the built-in dictionaries
+	humanDecoder := &HumanDecoder{}
+	if label, err := humanDecoder.Label(&ignition, FamilyFMBXY); err != nil || label != "Ignition On" {
+		t.Errorf("want Ignition On, got %q, %v", label, err)
+	}
+	flags, err := humanDecoder.Flags(&doors, FamilyFMBXY)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name := range flags {
+		if name == "Changed" {
+			t.Errorf("want built-in Bits, got %v", flags)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+// crc16IBM calculates CRC-16/IBM (polynomial 0xA001, reflected, initial value 0x0000) used by Teltonika TCP frames
+// https://wiki.teltonika.lt/view/Codec#CRC-16
+func crc16IBM(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b)
+		for i := 0; i < 8; i++ {
+			if crc&0x0001 != 0 {
+				crc = (crc >> 1) ^ 0xA001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
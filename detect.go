@@ -0,0 +1,255 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"fmt"
+	"sort"
+	"sync/atomic"
+)
+
+// builtinFamilies is the order of built-in device families, a tie of scores is resolved by this order
+var builtinFamilies = []string{FamilyFMBXY, FamilyFM64, FamilyFM36, FamilyFM11XY}
+
+const (
+	// detectionMinElements is a number of IO elements in the history of IMEI needed to cache the decision
+	detectionMinElements = 20
+	// detectionMinConfidence is a confidence needed to cache the decision for IMEI
+	detectionMinConfidence = 0.9
+	// detectionHistory is a number of IO elements kept in the history of IMEI, older elements are weighted down
+	detectionHistory = 1000
+	// detectionMaxIMEIs is a number of IMEIs kept in the history, the least recently seen half is dropped when it is reached
+	detectionMaxIMEIs = 100000
+)
+
+// Detection is a result of device family detection
+type Detection struct {
+	Family     string             // Best matching device family
+	Confidence float64            // Score of Family from 0 to 1, share of IO elements explained by the family
+	Scores     map[string]float64 // Scores of all known families
+	Elements   int                // Number of IO elements the detection is based on
+	Cached     bool               // Detection was returned from the cache of IMEI
+}
+
+// familyHistory is a rolling history of scores for one IMEI
+type familyHistory struct {
+	seen     uint64 // value of HumanDecoder.seen when IMEI was last detected, accessed atomically and first for 64-bit alignment
+	sums     map[string]float64
+	elements float64
+	decision *Detection
+}
+
+// DetectFamily takes a pointer to a slice of AvlData and return the device family which matches IO IDs and their lengths best.
+// Every IO element scores 1 for a family which knows its ID with the same length, 0.5 if the length can not be checked,
+// 0 if the ID is unknown and -1 if the length is different
+func (h *HumanDecoder) DetectFamily(data *[]AvlData) (Detection, error) {
+	dictionaries := h.dictionaries()
+	sums, elements := scoreFamilies(dictionaries, data)
+	return detection(dictionaries, sums, float64(elements))
+}
+
+// DetectIMEIFamily works as DetectFamily but scores a rolling history of IO elements received from imei,
+// once the history is long enough and the confidence is high, the decision is cached and returned without scoring.
+// The history is dropped when dictionaries are loaded, the least recently seen IMEIs are dropped when there are too many of them
+func (h *HumanDecoder) DetectIMEIFamily(imei string, data *[]AvlData) (Detection, error) {
+	h.mu.RLock()
+	elements, version := h.elements, h.version
+	if hist, ok := h.history[imei]; ok && hist.decision != nil {
+		atomic.StoreUint64(&hist.seen, atomic.AddUint64(&h.seen, 1))
+		decision := *hist.decision
+		h.mu.RUnlock()
+		return decision, nil
+	}
+	h.mu.RUnlock()
+
+	dictionaries := elements
+	if dictionaries == nil {
+		dictionaries = builtinElements()
+	}
+	// scoring does not need the lock, only merging into the history does
+	sums, scored := scoreFamilies(dictionaries, data)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	// dictionaries were replaced while scoring, the scores do not belong to the new history
+	if h.version != version {
+		return detection(dictionaries, sums, float64(scored))
+	}
+	if h.history == nil {
+		h.history = make(map[string]*familyHistory)
+	}
+
+	hist, ok := h.history[imei]
+	if !ok {
+		if len(h.history) >= detectionMaxIMEIs {
+			h.pruneHistory(detectionMaxIMEIs / 2)
+		}
+		hist = &familyHistory{sums: make(map[string]float64)}
+		h.history[imei] = hist
+	}
+	atomic.StoreUint64(&hist.seen, atomic.AddUint64(&h.seen, 1))
+	// other goroutine cached the decision while scoring
+	if hist.decision != nil {
+		return *hist.decision, nil
+	}
+
+	// weight down old elements to keep the history rolling
+	if hist.elements+float64(scored) > detectionHistory {
+		keep := float64(detectionHistory-scored) / hist.elements
+		if keep < 0 {
+			keep = 0
+		}
+		for family := range hist.sums {
+			hist.sums[family] *= keep
+		}
+		hist.elements *= keep
+	}
+	for family, sum := range sums {
+		hist.sums[family] += sum
+	}
+	hist.elements += float64(scored)
+
+	detected, err := detection(dictionaries, hist.sums, hist.elements)
+	if err != nil {
+		return Detection{}, err
+	}
+	if detected.Elements >= detectionMinElements && detected.Confidence >= detectionMinConfidence {
+		cached := detected
+		cached.Cached = true
+		hist.decision = &cached
+	}
+	return detected, nil
+}
+
+// pruneHistory drops the least recently seen IMEIs so that at most keep IMEIs stay in the history, h.mu must be locked
+func (h *HumanDecoder) pruneHistory(keep int) {
+	if len(h.history) <= keep {
+		return
+	}
+	if keep <= 0 {
+		h.history = make(map[string]*familyHistory)
+		return
+	}
+	seen := make([]uint64, 0, len(h.history))
+	for _, hist := range h.history {
+		seen = append(seen, atomic.LoadUint64(&hist.seen))
+	}
+	sort.Slice(seen, func(i, j int) bool { return seen[i] > seen[j] })
+	// seen values are unique, IMEIs older than the keep-th most recent one are dropped
+	oldest := seen[keep-1]
+	for imei, hist := range h.history {
+		if atomic.LoadUint64(&hist.seen) < oldest {
+			delete(h.history, imei)
+		}
+	}
+}
+
+// CachedFamily return the cached decision for imei
+func (h *HumanDecoder) CachedFamily(imei string) (Detection, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if hist, ok := h.history[imei]; ok && hist.decision != nil {
+		return *hist.decision, true
+	}
+	return Detection{}, false
+}
+
+// ForgetIMEI removes the history and the cached decision of imei
+func (h *HumanDecoder) ForgetIMEI(imei string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.history, imei)
+}
+
+// families return device families of dictionaries, built-in families go first
+func families(dictionaries map[string]map[uint16]AvlEncodeKey) []string {
+	families := make([]string, 0, len(dictionaries))
+	for _, family := range builtinFamilies {
+		if _, ok := dictionaries[family]; ok {
+			families = append(families, family)
+		}
+	}
+	var loaded []string
+	for family := range dictionaries {
+		if !isBuiltinFamily(family) {
+			loaded = append(loaded, family)
+		}
+	}
+	sort.Strings(loaded)
+	return append(families, loaded...)
+}
+
+// isBuiltinFamily reports whether family is one of built-in families
+func isBuiltinFamily(family string) bool {
+	for _, f := range builtinFamilies {
+		if f == family {
+			return true
+		}
+	}
+	return false
+}
+
+// scoreFamilies return sum of scores of IO elements for every family and the number of scored IO elements
+func scoreFamilies(dictionaries map[string]map[uint16]AvlEncodeKey, data *[]AvlData) (map[string]float64, int) {
+	sums := make(map[string]float64, len(dictionaries))
+	elements := 0
+	for _, avl := range *data {
+		for _, el := range avl.Elements {
+			if el.IOID == 0 || len(el.Value) == 0 {
+				continue
+			}
+			elements++
+			for family, keys := range dictionaries {
+				key, ok := keys[el.IOID]
+				if !ok {
+					continue
+				}
+				sums[family] += scoreLength(&key, len(el.Value))
+			}
+		}
+	}
+	return sums, elements
+}
+
+// scoreLength scores a length of IO element against the decoding key
+func scoreLength(key *AvlEncodeKey, length int) float64 {
+	// Variable lengths can not be checked
+	if key.size <= 0 || (key.size > 8 && key.FinalConversion == "to[]byte") {
+		return 0.5
+	}
+	if key.size != length {
+		return -1
+	}
+	return 1
+}
+
+// detection picks the best family from sums of scores
+func detection(dictionaries map[string]map[uint16]AvlEncodeKey, sums map[string]float64, elements float64) (Detection, error) {
+	if elements <= 0 {
+		return Detection{}, fmt.Errorf("Unable to detect device family, no IO elements")
+	}
+
+	detected := Detection{
+		Scores:   make(map[string]float64, len(dictionaries)),
+		Elements: int(elements + 0.5),
+	}
+	best := -1.0
+	for _, family := range families(dictionaries) {
+		score := sums[family] / elements
+		if score < 0 {
+			score = 0
+		}
+		detected.Scores[family] = score
+		if score > best {
+			best = score
+			detected.Family = family
+			detected.Confidence = score
+		}
+	}
+	if detected.Confidence == 0 {
+		return detected, fmt.Errorf("Unable to detect device family, no family knows the IO elements")
+	}
+	return detected, nil
+}
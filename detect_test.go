@@ -0,0 +1,223 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// FMB device, UDP Codec 8 Extended
+const detectFMBPacket = `0086cafe0101000f3335323039333038353639383230368e0100000167efa919800200000000000000000000000000000000fc0013000800ef0000f00000150500c80000450200010000710000fc00000900b5000000b600000042305600cd432a00ce6064001100090012ff22001303d1000f0000000200f1000059d90010000000000000000001`
+
+// FM1100 device with LVCAN, UDP Codec 8
+const detectFM11Packet = `01e4cafe0126000f333532303934303839333937343634080400000163c803b420010a259e1a1d4a057d00da0128130057421b0a4503f00150051503ef01510052005900be00c1000ab50008b60005427025cd79d8ce605a5400005500007300005a0000c0000007c700000018f1000059d910002d32c85300000000570000000064000000f7bf000000000000000163c803ac50010a25a9d21d4a01b600db0128130056421b0a4503f00150051503ef01510052005900be00c1000ab50008b6000542702ecd79d8ce605a5400005500007300005a0000c0000007c700000017f1000059d910002d32b05300000000570000000064000000f7bf000000000000000163c803a868010a25b5581d49fe5400db0127130057421b0a4503f00150051503ef01510052005900be00c1000ab50008b60005427039cd79d8ce605a5400005500007300005a0000c0000007c700000017f1000059d910002d32995300000000570000000064000000f7bf000000000000000163c803a4b2010a25cc861d49f75c00db0124130058421b0a4503f00150051503ef01510052005900be00c1000ab50008b6000542703ccd79d8ce605a5400005500007300005a0000c0000007c700000018f1000059d910002d32695300000000570000000064000000f7bf000000000004`
+
+func decodeDetectPacket(t *testing.T, stringData string) Decoded {
+	bs, _ := hex.DecodeString(stringData)
+	decoded, err := DecodeUDP(&bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return decoded
+}
+
+func ExampleHumanDecoder_DetectFamily() {
+	bs, _ := hex.DecodeString(detectFMBPacket)
+
+	// decode a raw data byte slice
+	parsedData, err := DecodeUDP(&bs)
+	if err != nil {
+		log.Panicf("Error when decoding a bs, %v\n", err)
+	}
+
+	humanDecoder := HumanDecoder{}
+	detected, err := humanDecoder.DetectFamily(&parsedData.Data)
+	if err != nil {
+		log.Panicf("Unable to detect device family, %v\n", err)
+	}
+	fmt.Printf("%v, confidence %.2f, FM11XY %.2f\n", detected.Family, detected.Confidence, detected.Scores["FM11XY"])
+
+	// Output:
+	// FMBXY, confidence 1.00, FM11XY 0.74
+}
+
+func TestDetectFamily(t *testing.T) {
+	tests := []struct {
+		name   string
+		packet string
+		want   string
+	}{
+		{"FMB", detectFMBPacket, "FMBXY"},
+		{"FM1100", detectFM11Packet, "FM11XY"},
+	}
+
+	humanDecoder := HumanDecoder{}
+	for _, tt := range tests {
+		decoded := decodeDetectPacket(t, tt.packet)
+		detected, err := humanDecoder.DetectFamily(&decoded.Data)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.name, err)
+		}
+		if detected.Family != tt.want || detected.Confidence <= 0 || detected.Confidence > 1 {
+			t.Errorf("%v: want %v, got %v with confidence %v", tt.name, tt.want, detected.Family, detected.Confidence)
+		}
+		for family, score := range detected.Scores {
+			if score > detected.Confidence {
+				t.Errorf("%v: %v scores %v, more than detected %v", tt.name, family, score, detected.Confidence)
+			}
+		}
+	}
+
+	// no IO elements
+	if _, err := humanDecoder.DetectFamily(&[]AvlData{{}}); err == nil {
+		t.Error("want error for data without IO elements")
+	}
+
+	// unknown IO elements
+	unknown := []AvlData{{Elements: []Element{{Length: 1, IOID: 65000, Value: []byte{0x01}}}}}
+	if _, err := humanDecoder.DetectFamily(&unknown); err == nil {
+		t.Error("want error for unknown IO elements")
+	}
+}
+
+func TestDetectIMEIFamily(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+	fmb := decodeDetectPacket(t, detectFMBPacket)
+	imei := fmb.IMEI
+
+	// 19 IO elements are not enough to cache the decision
+	detected, err := humanDecoder.DetectIMEIFamily(imei, &fmb.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if detected.Family != "FMBXY" || detected.Cached {
+		t.Fatalf("want FMBXY not cached, got %+v", detected)
+	}
+	if _, ok := humanDecoder.CachedFamily(imei); ok {
+		t.Fatal("want no cached decision after one packet")
+	}
+
+	// the second packet extends the history
+	if _, err := humanDecoder.DetectIMEIFamily(imei, &fmb.Data); err != nil {
+		t.Fatal(err)
+	}
+	cached, ok := humanDecoder.CachedFamily(imei)
+	if !ok || cached.Family != "FMBXY" || cached.Elements != 38 {
+		t.Fatalf("want cached FMBXY of 38 elements, got %+v", cached)
+	}
+
+	// cached decision is returned for any data
+	fm11 := decodeDetectPacket(t, detectFM11Packet)
+	detected, err = humanDecoder.DetectIMEIFamily(imei, &fm11.Data)
+	if err != nil || !detected.Cached || detected.Family != "FMBXY" {
+		t.Errorf("want cached FMBXY, got %+v, %v", detected, err)
+	}
+
+	// forgotten IMEI is detected again
+	humanDecoder.ForgetIMEI(imei)
+	detected, err = humanDecoder.DetectIMEIFamily(imei, &fm11.Data)
+	if err != nil || detected.Family != "FM11XY" {
+		t.Errorf("want FM11XY, got %+v, %v", detected, err)
+	}
+}
+
+func TestDetectIMEIFamilyLoad(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+	fmb := decodeDetectPacket(t, detectFMBPacket)
+	for i := 0; i < 2; i++ {
+		if _, err := humanDecoder.DetectIMEIFamily(fmb.IMEI, &fmb.Data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := humanDecoder.CachedFamily(fmb.IMEI); !ok {
+		t.Fatal("want cached decision")
+	}
+
+	// loaded dictionaries drop the cached decisions
+	dictionary := `{"10900": {"PropertyName": "Custom sensor", "Bytes": "2", "Type": "Unsigned", "FinalConversion": "toUint16"}}`
+	if err := humanDecoder.LoadJSON("CUSTOM", strings.NewReader(dictionary)); err != nil {
+		t.Fatal(err)
+	}
+	if cached, ok := humanDecoder.CachedFamily(fmb.IMEI); ok {
+		t.Errorf("want no cached decision after LoadJSON, got %+v", cached)
+	}
+}
+
+func TestPruneHistory(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+	fmb := decodeDetectPacket(t, detectFMBPacket)
+	for _, imei := range []string{"1", "2", "3", "4"} {
+		if _, err := humanDecoder.DetectIMEIFamily(imei, &fmb.Data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// IMEI 1 is seen again, 3 and 1 are the most recent
+	for _, imei := range []string{"3", "1"} {
+		if _, err := humanDecoder.DetectIMEIFamily(imei, &fmb.Data); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	humanDecoder.mu.Lock()
+	humanDecoder.pruneHistory(2)
+	kept := make([]string, 0, len(humanDecoder.history))
+	for imei := range humanDecoder.history {
+		kept = append(kept, imei)
+	}
+	humanDecoder.mu.Unlock()
+
+	sort.Strings(kept)
+	if strings.Join(kept, ",") != "1,3" {
+		t.Errorf("want IMEIs 1,3 in the history, got %v", kept)
+	}
+}
+
+func TestDetectIMEIFamilyConcurrent(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+	fmb := decodeDetectPacket(t, detectFMBPacket)
+	dictionary := `{"10900": {"PropertyName": "Custom sensor", "Bytes": "2", "Type": "Unsigned", "FinalConversion": "toUint16"}}`
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				data := append([]AvlData(nil), fmb.Data...)
+				if _, err := humanDecoder.DetectIMEIFamily(fmt.Sprint(j%5), &data); err != nil {
+					t.Error(err)
+					return
+				}
+				if i == 0 && j%10 == 0 {
+					if err := humanDecoder.LoadJSON("CUSTOM", strings.NewReader(dictionary)); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDetectLoadedFamily(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+	dictionary := `{"10900": {"PropertyName": "Custom sensor", "Bytes": "2", "Type": "Unsigned", "FinalConversion": "toUint16"}}`
+	if err := humanDecoder.LoadJSON("CUSTOM", strings.NewReader(dictionary)); err != nil {
+		t.Fatal(err)
+	}
+
+	data := []AvlData{{Elements: []Element{{Length: 2, IOID: 10900, Value: []byte{0x00, 0x01}}}}}
+	detected, err := humanDecoder.DetectFamily(&data)
+	if err != nil || detected.Family != "CUSTOM" || detected.Confidence != 1 {
+		t.Errorf("want CUSTOM, got %+v, %v", detected, err)
+	}
+}
@@ -0,0 +1,934 @@
+// Code generated by go run ./internal/dictgen; DO NOT EDIT.
+
+package teltonikaparser
+
+// builtinDictionaries holds the dictionaries of ./teltonikajson/, they are shared by all decoders and must not be modified
+var builtinDictionaries = map[string]map[uint16]AvlEncodeKey{
+	FamilyFMBXY: {
+		1:   {No: "18", PropertyName: "Digital Input 1", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964", ParametrGroup: "Permanent I/O elements", FinalConversion: "toBool", size: 1},
+		2:   {No: "28", PropertyName: "Digital Input 2", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB100, FMB110, FMB120, FMB122", ParametrGroup: "Permanent I/O elements", FinalConversion: "toBool", size: 1},
+		3:   {No: "29", PropertyName: "Digital Input 3", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB100, FMB110, FMB120, FMB122", ParametrGroup: "Permanent I/O elements", FinalConversion: "toBool", size: 1},
+		4:   {No: "70", PropertyName: "Pulse Counter Din1", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Counts pulses, count is reset when recors is saved", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		5:   {No: "71", PropertyName: "Pulse Counter Din2", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Counts pulses, count is reset when recors is saved", HWSupport: "FMB100, FMB110, FMB120, FMB122", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		6:   {No: "30", PropertyName: "Analog Input 2", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "mV", Description: "Voltage, mV", HWSupport: "FMB100, FMB110, FMB120, FMB122", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mV"},
+		7:   {No: "72", PropertyName: "Records In Flash", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "-", Description: "Shows record count left in device memory.", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2},
+		8:   {No: "69", PropertyName: "Authorized iButton", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "If ID is shown in this I/O that means that attached iButton is in iButton List", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		9:   {No: "19", PropertyName: "Analog Input 1", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "mV", Description: "Voltage, mV", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mV"},
+		10:  {No: "27", PropertyName: "SD Status", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - not present 1 - present", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Not Present", "1": "Present"}, FinalConversion: "toBool", size: 1},
+		11:  {No: "26", PropertyName: "ICCID1", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Value of SIM ICCID, MSB", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		12:  {No: "21", PropertyName: "Fuel Used GPS", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "ml", Description: "Fuel Used, ml", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4, units: "ml"},
+		13:  {No: "22", PropertyName: "Fuel Rate GPS", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32767", Multiplier: "0.01", Units: "l/h", Description: "Average Fuel Use, l/h", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.01, units: "l/h"},
+		14:  {No: "250", PropertyName: "ICCID2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Value of SIM ICCID, LSB", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", FinalConversion: "to[]byte", size: 8},
+		15:  {No: "52", PropertyName: "Eco Score", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.01", Units: "-", Description: "Average amount of events on some distance", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.01},
+		16:  {No: "17", PropertyName: "Total Odometer", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Total Odometer value in meters", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		17:  {No: "23", PropertyName: "Axis X", Bytes: "2", Type: "Signed", Min: "-8000", Max: "8000", Multiplier: "-", Units: "mG", Description: "X axis value, mG", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "mG"},
+		18:  {No: "24", PropertyName: "Axis Y", Bytes: "2", Type: "Signed", Min: "-8000", Max: "8000", Multiplier: "-", Units: "mG", Description: "Y axis value, mG", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "mG"},
+		19:  {No: "25", PropertyName: "Axis Z", Bytes: "2", Type: "Signed", Min: "-8000", Max: "8000", Multiplier: "-", Units: "mG", Description: "Z axis value, mG", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "mG"},
+		20:  {No: "61", PropertyName: "BLE 2 Battery Voltage", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Battery voltage of sensor #2", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		21:  {No: "4", PropertyName: "GSM Signal", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "Value in range 1-5", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		22:  {No: "62", PropertyName: "BLE 3 Battery Voltage", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Battery voltage of sensor #3", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		23:  {No: "63", PropertyName: "BLE 4 Battery Voltage", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Battery voltage of sensor #4", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		24:  {No: "10", PropertyName: "Speed", Bytes: "2", Type: "Unsigned", Min: "0", Max: "350", Multiplier: "-", Units: "km/h", Description: "Value km/h", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "km/h"},
+		25:  {No: "56", PropertyName: "BLE 1 Temperature", Bytes: "2", Type: "Signed", Min: "-400", Max: "1250", Multiplier: "0.1", Units: "°C", Description: "Degrees ( °C ), -40 - +125; Error codes:4000 - abnormal sensor state 3000 - sensor not found 2000 - failed sensor data parsing", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		26:  {No: "57", PropertyName: "BLE 2 Temperature", Bytes: "2", Type: "Signed", Min: "-400", Max: "1250", Multiplier: "0.1", Units: "°C", Description: "Degrees ( °C ), -40 - +125; Error codes:4000 - abnormal sensor state 3000 - sensor not found 2000 - failed sensor data parsing", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		27:  {No: "58", PropertyName: "BLE 3 Temperature", Bytes: "2", Type: "Signed", Min: "-400", Max: "1250", Multiplier: "0.1", Units: "°C", Description: "Degrees ( °C ), -40 - +125; Error codes:4000 - abnormal sensor state 3000 - sensor not found 2000 - failed sensor data parsing", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		28:  {No: "59", PropertyName: "BLE 4 Temperature", Bytes: "2", Type: "Signed", Min: "-400", Max: "1250", Multiplier: "0.1", Units: "°C", Description: "Degrees ( °C ), -40 - +125; Error codes:4000 - abnormal sensor state 3000 - sensor not found 2000 - failed sensor data parsing", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		29:  {No: "60", PropertyName: "BLE 1 Battery Voltage", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Battery voltage of sensor #1", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		30:  {No: "75", PropertyName: "Number of DTC", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", Description: "Number of DTC", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1},
+		31:  {No: "76", PropertyName: "Engine Load", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Calculated engine load value, %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		32:  {No: "77", PropertyName: "Coolant Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Engine coolant temperature, °C", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		33:  {No: "78", PropertyName: "Short Fuel Trim", Bytes: "1", Type: "Signed", Min: "-100", Max: "99", Multiplier: "-", Units: "%", Description: "Short term fuel trim 1, %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toInt8", size: 1, signed: true, units: "%"},
+		34:  {No: "79", PropertyName: "Fuel pressure", Bytes: "2", Type: "Unsigned", Min: "0", Max: "765", Multiplier: "-", Units: "kPa", Description: "Fuel pressure, kPa", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, units: "kPa"},
+		35:  {No: "80", PropertyName: "Intake MAP", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "kPa", Description: "Intake manifold absolute pressure, kPa", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1, units: "kPa"},
+		36:  {No: "81", PropertyName: "Engine RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "16384", Multiplier: "-", Units: "rpm", Description: "EngineRPM, rpm", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, units: "rpm"},
+		37:  {No: "82", PropertyName: "Vehicle Speed", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "km/h", Description: "Vehicle speed, km/h", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1, units: "km/h"},
+		38:  {No: "83", PropertyName: "Timing Advance", Bytes: "2", Type: "Signed", Min: "-64", Max: "64", Multiplier: "-", Units: "°", Description: "Timing advance, degrees °", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toInt16", size: 2, signed: true, units: "°"},
+		39:  {No: "84", PropertyName: "Intake Air Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Intake air temperature, °C", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		40:  {No: "85", PropertyName: "MAF", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.01", Units: "g/sec", Description: "MAF air flow rate, g/sec", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, multiplier: 0.01, units: "g/sec"},
+		41:  {No: "86", PropertyName: "Throttle Position", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Throttle position, %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		42:  {No: "87", PropertyName: "Run Time Since Engine Start", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "s", Description: "Run time since engine start, s", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, units: "s"},
+		43:  {No: "88", PropertyName: "Distance Traveled MIL On", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "km", Description: "Distance ormattin MIL on, km", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, units: "km"},
+		44:  {No: "89", PropertyName: "Relative Fuel Rail Pressure", Bytes: "2", Type: "Unsigned", Min: "0", Max: "5178", Multiplier: "0.1", Units: "kPa", Description: "Relative fuel rail pressure, kPa", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "kPa"},
+		45:  {No: "90", PropertyName: "Direct Fuel Rail Pressure", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "10", Units: "kPa", Description: "Direct fuel rail pressure, kPa", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, multiplier: 10, units: "kPa"},
+		46:  {No: "91", PropertyName: "Commanded EGR", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Commanded EGR, %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		47:  {No: "92", PropertyName: "EGR Error", Bytes: "1", Type: "Signed", Min: "-100", Max: "100", Multiplier: "-", Units: "%", Description: "EGR error, %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toInt8", size: 1, signed: true, units: "%"},
+		48:  {No: "93", PropertyName: "Fuel Level", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Fuel level, %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		49:  {No: "94", PropertyName: "Distance Since Codes Clear", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "km", Description: "Distance ormattin since codes cleared, km", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, units: "km"},
+		50:  {No: "95", PropertyName: "Barometric Pressure", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "kPa", Description: "Barometric pressure, kPa", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1, units: "kPa"},
+		51:  {No: "96", PropertyName: "Control Module Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "mV", Description: "Control module voltage, mV", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, units: "mV"},
+		52:  {No: "97", PropertyName: "Absolute Load Value", Bytes: "2", Type: "Unsigned", Min: "0", Max: "25700", Multiplier: "1", Units: "%", Description: "Absolute load value, %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "%"},
+		53:  {No: "98", PropertyName: "Ambient Air Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Ambient air temperature, °C", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		54:  {No: "99", PropertyName: "Time Run With MIL On", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Time run with MIL on, min", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		55:  {No: "100", PropertyName: "Time Since Codes Cleared", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Time since trouble codes cleared, min", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		56:  {No: "101", PropertyName: "Absolute Fuel Rail Pressure", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "kPa", Description: "Absolute fuel rail pressure, kPa", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "kPa"},
+		57:  {No: "102", PropertyName: "Hybrid battery pack life", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Hybrid battery pack remaining life, %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		58:  {No: "103", PropertyName: "Engine Oil Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Engine oil temperature, °C", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		59:  {No: "104", PropertyName: "Fuel Injection Timing", Bytes: "2", Type: "Signed", Min: "-21000", Max: "30200", Multiplier: "0.01", Units: "°", Description: "Fuel injection timing, degrees °", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.01, units: "°"},
+		60:  {No: "105", PropertyName: "Fuel Rate", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32767", Multiplier: "0.01", Units: "l/100km", Description: "Engine fuel rate, l/100km", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toUint16", size: 2, multiplier: 0.01, units: "l/100km"},
+		61:  {No: "194", PropertyName: "Geofence zone 06", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		62:  {No: "195", PropertyName: "Geofence zone 07", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		63:  {No: "196", PropertyName: "Geofence zone 08", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		64:  {No: "197", PropertyName: "Geofence zone 09", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		65:  {No: "198", PropertyName: "Geofence zone 10", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		66:  {No: "9", PropertyName: "External Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.001", Units: "V", Description: "Voltage mV", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		67:  {No: "13", PropertyName: "Battery Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "mV", Description: "Voltage, mV", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mV"},
+		68:  {No: "14", PropertyName: "Battery Current", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "mA", Description: "Current, mA", HWSupport: "FMB001, FMB010, FMB120, FMB122, FMB125, FMB920, FMB962, FMB964", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mA"},
+		69:  {No: "6", PropertyName: "GNSS Status", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 - OFF 1 – ON with fix 2 - ON without fix 3 - In sleep state", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "OFF", "1": "ON With Fix", "2": "ON Without Fix", "3": "In Sleep State"}, FinalConversion: "toUint8", size: 1},
+		70:  {No: "199", PropertyName: "Geofence zone 11", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		71:  {No: "39", PropertyName: "Dallas Temperature ID 4", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		72:  {No: "32", PropertyName: "Dallas Temperature 1", Bytes: "4", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0.1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt32", size: 4, signed: true, multiplier: 0.1, units: "°C"},
+		73:  {No: "33", PropertyName: "Dallas Temperature 2", Bytes: "4", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0.1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt32", size: 4, signed: true, multiplier: 0.1, units: "°C"},
+		74:  {No: "34", PropertyName: "Dallas Temperature 3", Bytes: "4", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0.1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt32", size: 4, signed: true, multiplier: 0.1, units: "°C"},
+		75:  {No: "35", PropertyName: "Dallas Temperature 4", Bytes: "4", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0.1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt32", size: 4, signed: true, multiplier: 0.1, units: "°C"},
+		76:  {No: "36", PropertyName: "Dallas Temperature ID 1", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		77:  {No: "37", PropertyName: "Dallas Temperature ID 2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		78:  {No: "40", PropertyName: "iButton", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "iButton ID", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		79:  {No: "38", PropertyName: "Dallas Temperature ID 3", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		80:  {No: "3", PropertyName: "Data Mode", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "0 – Home On Stop 1 – Home On Moving 2 – Roaming On Stop 3 – Roaming On Moving 4 – Unknown On Stop 5 – Unknown On Moving", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Home On Stop", "1": "Home On Moving", "2": "Roaming On Stop", "3": "Roaming On Moving", "4": "Unknown On Stop", "5": "Unknown On Moving"}, FinalConversion: "toUint8", size: 1},
+		81:  {No: "106", PropertyName: "Vehicle Speed", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "km/h", Description: "Value in km/h", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "km/h"},
+		82:  {No: "107", PropertyName: "Accelerator Pedal Position", Bytes: "1", Type: "Unsigned", Min: "0", Max: "102", Multiplier: "-", Units: "%", Description: "Value in persentages, %", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		83:  {No: "108", PropertyName: "Fuel Consumed", Bytes: "4", Type: "Unsigned", Min: "0", Max: "2147483647", Multiplier: "0.1", Units: "l", Description: "Value in liters, L", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "l"},
+		84:  {No: "109", PropertyName: "Fuel Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "l", Description: "Value in liters, L", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "l"},
+		85:  {No: "110", PropertyName: "Engine RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "16384", Multiplier: "-", Units: "rpm", Description: "Value in rounds per minute, rpm", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "rpm"},
+		86:  {No: "64", PropertyName: "BLE 1 Humidity", Bytes: "2", Type: "Unsigned", Min: "0", Max: "1000", Multiplier: "0.1", Units: "%RH", Description: "Relative humidity", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "%RH"},
+		87:  {No: "111", PropertyName: "Total Mileage", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Value in meters, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		88:  {No: "200", PropertyName: "Geofence zone 12", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		89:  {No: "112", PropertyName: "Fuel Level", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Value in percentages, %", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		90:  {No: "113", PropertyName: "Door Status", Bytes: "2", Type: "Unsigned", Min: "0", Max: "16128", Multiplier: "-", Units: "-", Description: "Door status value: Min – 0, Max – 16128 Door status is represented as bitmask converted to decimal value. Possible values: 0 – all doors closed, 0x100 (256) – front left door is opened, 0x200 (512) – front right door is opened, 0x400 (1024) – rear left door is opened, 0x800 (2048) – rear right door is opened, 0x1000 (4096) – hood is opened, 0x2000 (8192) – trunk is opened, 0x3F00 (16128) – all doors are opened, or combinations of values", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", Bits: map[string]string{"8": "Front left door open", "9": "Front right door open", "10": "Rear left door open", "11": "Rear right door open", "12": "Hood open", "13": "Trunk open"}, FinalConversion: "toUint16", size: 2},
+		91:  {No: "201", PropertyName: "Geofence zone 13", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		92:  {No: "202", PropertyName: "Geofence zone 14", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		93:  {No: "203", PropertyName: "Geofence zone 15", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		94:  {No: "204", PropertyName: "Geofence zone 16", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		95:  {No: "205", PropertyName: "Geofence zone 17", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		96:  {No: "206", PropertyName: "Geofence zone 18", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		97:  {No: "207", PropertyName: "Geofence zone 19", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		98:  {No: "208", PropertyName: "Geofence zone 20", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		99:  {No: "209", PropertyName: "Geofence zone 21", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		100: {No: "114", PropertyName: "Program Number", Bytes: "4", Type: "Unsigned", Min: "0", Max: "999", Multiplier: "-", Units: "-", Description: "Value: Min - 0, Max - 999", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4},
+		101: {No: "115", PropertyName: "Module ID", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Module ID", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "to[]byte", size: 8},
+		102: {No: "116", PropertyName: "Engine Worktime", Bytes: "4", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "min", Description: "Engine work time in minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "min"},
+		103: {No: "117", PropertyName: "Engine Worktime (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "min", Description: "total Engine work time in minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "min"},
+		104: {No: "65", PropertyName: "BLE 2 Humidity", Bytes: "2", Type: "Unsigned", Min: "0", Max: "1000", Multiplier: "0.1", Units: "%RH", Description: "Relative humidity", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "%RH"},
+		105: {No: "118", PropertyName: "Total Mileage (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Total Vehicle Mileage, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		106: {No: "66", PropertyName: "BLE 3 Humidity", Bytes: "2", Type: "Unsigned", Min: "0", Max: "1000", Multiplier: "0.1", Units: "%RH", Description: "Relative humidity", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "%RH"},
+		107: {No: "119", PropertyName: "Fuel Consumed (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "2147483647", Multiplier: "0.1", Units: "l", Description: "Total Fuel Consumed, l", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "l"},
+		108: {No: "67", PropertyName: "BLE 4 Humidity", Bytes: "2", Type: "Unsigned", Min: "0", Max: "1000", Multiplier: "0.1", Units: "%RH", Description: "Relative humidity", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "%RH"},
+		109: {No: "unknown", PropertyName: "Delimiter", Bytes: "Variable", Type: "Unsigned", Multiplier: "-", Description: "Packet received on RS232", FinalConversion: "to[]byte"},
+		110: {No: "120", PropertyName: "Fuel Rate", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "0.1", Units: "l/h", Description: "Fuel Rate, l/h", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "l/h"},
+		111: {No: "121", PropertyName: "AdBlue Level", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "AdBlue, %", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		112: {No: "122", PropertyName: "AdBlue Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "l", Description: "AdBlue level, L", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "l"},
+		113: {No: "53", PropertyName: "Battery Level", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "FM devices battery capacity level in %", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		114: {No: "123", PropertyName: "Engine Load", Bytes: "1", Type: "Unsigned", Min: "0", Max: "130", Multiplier: "-", Units: "%", Description: "Engine Load, %", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		115: {No: "124", PropertyName: "Engine Temperature", Bytes: "2", Type: "Signed", Min: "-600", Max: "1270", Multiplier: "0.1", Units: "°C", Description: "Engine Temperature, °C", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		116: {No: "54", PropertyName: "Charger Connected", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - charger is not connected 1 - charger is connected", HWSupport: "TMT250", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Charger Is Not Connected", "1": "Charger Is Connected"}, FinalConversion: "toUint8", size: 1},
+		117: {No: "73", PropertyName: "Driving Direction", Bytes: "1", Type: "Unsigned", Min: "0", Max: "2", Multiplier: "-", Units: "-", Description: "0 – Unknown 1 – Forward 2 – Backward", HWSupport: "HW with gyro (LSM6DSL)", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Unknown", "1": "Forward", "2": "Backward"}, FinalConversion: "toUint8", size: 1},
+		118: {No: "125", PropertyName: "Axle 1 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 1 load, kg", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		119: {No: "126", PropertyName: "Axle 2 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 2 load, kg", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		120: {No: "127", PropertyName: "Axle 3 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 3 load, kg", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		121: {No: "128", PropertyName: "Axle 4 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 4 load, kg", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		122: {No: "129", PropertyName: "Axle 5 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 5 load, kg", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		123: {No: "130", PropertyName: "Control State Flags", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Control state flags", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", Bits: map[string]string{"0": "STOP", "1": "Oil pressure / level", "2": "Coolant liquid temperature / level", "3": "Handbrake system", "4": "Battery charging", "5": "AIRBAG", "8": "CHECK ENGINE", "9": "Lights failure", "10": "Low tire pressure", "11": "Wear of brake pads", "12": "Warning", "13": "ABS", "14": "Low Fuel", "16": "ESP", "17": "Glow plug indicator", "18": "FAP", "19": "Electronics pressure control", "20": "Parking lights", "21": "Dipped headlights", "22": "Full beam headlights", "30": "Passenger's seat belt", "31": "Driver's seat belt"}, FinalConversion: "toUint32", size: 4},
+		124: {No: "131", PropertyName: "Agricultural Machinery Flags", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Agricultural machinery flags", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", Bits: map[string]string{"0": "Mowing", "1": "Grain release from hopper", "2": "First front hydraulic turned on", "3": "Rear Power Take-Off turned on", "8": "Excessive play under the threshing drum", "9": "Grain tank is open", "10": "100% of Grain tank", "11": "70% of Grain tank", "12": "Drain filter in hydraulic system of drive cylinders is plugged", "13": "Pressure filter of drive cylinders hydraulic system is plugged", "14": "Alarm oil level in oil tank", "15": "Pressure filter of brakes hydraulic system is plugged", "16": "Oil filter of engine is plugged", "17": "Fuel filter is plugged", "18": "Air filter is plugged", "19": "Alarm oil temperature in hydraulic system of chasis", "20": "Alarm oil temperature in hydraulic system of drive cylinders", "21": "Alarm oil pressure in engine", "22": "Alarm coolant level", "23": "Overflow chamber of hydraulic unit", "24": "Unloader drive is ON", "25": "No operator", "26": "Straw walker is plugged", "27": "Water in fuel", "28": "Cleaning fan RPM", "29": "Trashing drum RPM", "33": "Low water level in the tank", "34": "First rear hydraulic turned on", "35": "Standalone engine working", "36": "Right joystick moved right", "37": "Right joystick moved left", "38": "Right joystick moved front", "39": "Right joystick moved back", "40": "Brushes turned on", "41": "Water supply turned on", "42": "Vacuum cleaner", "43": "Unloading from the hopper", "44": "High Pressure washer (Karcher)", "45": "Salt (sand) disperser ON", "46": "Low salt (sand) level", "48": "Second front hydraulic turned on", "49": "Third front hydraulic turned on", "50": "Fourth front hydraulic turned on", "51": "Second rear hydraulic turned on", "52": "Third rear hydraulic turned on", "53": "Fourth rear hydraulic turned on", "54": "Front three-point Hitch turned on", "55": "Rear three-point Hitch turned on", "56": "Left joystick moved right", "57": "Left joystick moved left", "58": "Left joystick moved front", "59": "Left joystick moved back", "60": "Front Power Take-Off turned on"}, FinalConversion: "to[]byte", size: 8},
+		125: {No: "132", PropertyName: "Harvesting Time", Bytes: "4", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "min", Description: "Harvesting Time, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "min"},
+		126: {No: "133", PropertyName: "Area of Harvest", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m^2", Description: "HArea of Harvest, m^2", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m^2"},
+		127: {No: "134", PropertyName: "LVC Mowing Efficiency", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m^2/h", Description: "Mowing efficiency, (m^2)/h", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m^2/h"},
+		128: {No: "135", PropertyName: "Grain Mown Volume", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "kg", Description: "Mown Volume, kg", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "kg"},
+		129: {No: "136", PropertyName: "Grain Moisture", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Grain Moisture in proc, %", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		130: {No: "137", PropertyName: "Harvesting Drum RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "rpm", Description: "Harvesting Drum RPM, RPM", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "rpm"},
+		131: {No: "138", PropertyName: "Gap Under Harvesting Drum", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "mm", Description: "Gap Under Harvesting Drum, mm", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "mm"},
+		132: {No: "139", PropertyName: "Security State Flags", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Security State Flag", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", Bits: map[string]string{"0": "CAN1 connected", "1": "CAN1 required", "2": "CAN2 connected", "3": "CAN2 required", "4": "CAN3 connected", "5": "CAN3 required", "6": "CAN4 connected", "7": "CAN4 required", "21": "Operate button pressed", "22": "Immobilizer in service mode", "23": "Immobilizer key sequence", "24": "Key in ignition lock", "25": "Ignition on", "26": "Dynamic ignition on", "27": "Webasto", "29": "Car closed by factory remote control", "30": "Factory alarm actuated", "31": "Factory alarm emulated by module", "32": "Parking activated", "36": "Handbrake actuated", "37": "Footbrake actuated", "38": "Engine working", "39": "Reverse on", "40": "Front left door open", "41": "Front right door open", "42": "Rear left door open", "43": "Rear right door open", "44": "Hood open", "45": "Trunk open", "55": "CAN module sleep mode"}, FinalConversion: "to[]byte", size: 8},
+		133: {No: "140", PropertyName: "Tacho Total Distance", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Tacho Total Vehicle Distance, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		134: {No: "141", PropertyName: "Trip Distance", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Trip Distance, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		135: {No: "142", PropertyName: "Tacho Vehicle Speed", Bytes: "2", Type: "Unsigned", Min: "0", Max: "256", Multiplier: "-", Units: "km/h", Description: "Tacho Vehicle Speed, km/h", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "km/h"},
+		136: {No: "143", PropertyName: "Tacho Driver Card Presence", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "Tacho Driver Card Presence", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1},
+		137: {No: "144", PropertyName: "Driver 1 States", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", Description: "Driver 1 States", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1},
+		138: {No: "145", PropertyName: "Driver 2 States", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", Description: "Driver 2 States", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1},
+		139: {No: "146", PropertyName: "Driver 1 Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Driver1 Continuous Driving Time, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		140: {No: "147", PropertyName: "Driver 2 Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Driver2 Continuous Driving Time, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		141: {No: "148", PropertyName: "Driver 1 Break Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Driver1 Cumulative Break Time, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		142: {No: "149", PropertyName: "Driver 2 Break Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Driver2 Cumulative Break Time, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		143: {No: "150", PropertyName: "Driver 1 Activity Duration", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Driver1 Duration Of Selected Activity, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		144: {No: "151", PropertyName: "Driver 2 Activity Duration", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Driver2 Duration Of Selected Activity, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		145: {No: "152", PropertyName: "Driver1 Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Driver1 Cumulative Driving Time, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		146: {No: "153", PropertyName: "Driver2 Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "min", Description: "Driver2 Cumulative Driving Time, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "min"},
+		147: {No: "154", PropertyName: "Driver 1 ID High", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Driver1 ID High", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "to[]byte", size: 8},
+		148: {No: "155", PropertyName: "Driver 1 ID Low", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Driver1 ID Low", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "to[]byte", size: 8},
+		149: {No: "156", PropertyName: "Driver 2 ID High", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Driver2 ID High", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "to[]byte", size: 8},
+		150: {No: "157", PropertyName: "Driver 2 ID Low", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Driver2 ID Low", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "to[]byte", size: 8},
+		151: {No: "158", PropertyName: "Battery Temperature", Bytes: "2", Type: "Signed", Min: "-600", Max: "1270", Multiplier: "0.1", Units: "°C", Description: "Degrees, °C", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		152: {No: "159", PropertyName: "Battery Level", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "Electric cars battery level in percentages, %", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		153: {No: "210", PropertyName: "Geofence zone 22", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		154: {No: "211", PropertyName: "Geofence zone 23", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		155: {No: "189", PropertyName: "Geofence zone 01", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		156: {No: "190", PropertyName: "Geofence zone 02", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		157: {No: "191", PropertyName: "Geofence zone 03", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		158: {No: "192", PropertyName: "Geofence zone 04", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		159: {No: "193", PropertyName: "Geofence zone 05", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		160: {No: "160", PropertyName: "DTC Faults", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", Description: "DTC Faults", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1},
+		161: {No: "161", PropertyName: "Slope Of Arm", Bytes: "1", Type: "Signed", Min: "-3276", Max: "3276", Multiplier: "-", Units: "°", Description: "Slope Of Arm, degrees °", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°"},
+		162: {No: "162", PropertyName: "Rotation Of Arm", Bytes: "1", Type: "Signed", Min: "-180", Multiplier: "-", Units: "°", Description: "Slope Of Arm, degrees °", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°"},
+		163: {No: "163", PropertyName: "Eject Of Arm", Bytes: "2", Type: "Unsigned", Min: "0", Max: "6553", Multiplier: "-", Units: "m", Description: "Eject Of Arm, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "m"},
+		164: {No: "164", PropertyName: "Horizontal Distance Arm", Bytes: "2", Type: "Unsigned", Min: "0", Max: "6553", Multiplier: "-", Units: "m", Description: "Horizontal Distance Arm Vehicle, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "m"},
+		165: {No: "165", PropertyName: "Height Arm Above Ground", Bytes: "2", Type: "Unsigned", Min: "0", Max: "6553", Multiplier: "-", Units: "m", Description: "Height Arm Above Ground, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "m"},
+		166: {No: "166", PropertyName: "Drill RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "rpm", Description: "Drill RPM, RPM", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "rpm"},
+		167: {No: "167", PropertyName: "Spread Salt", Bytes: "2", Type: "Unsigned", Min: "0", Max: "655", Multiplier: "-", Units: "g/m^2", Description: "Amount Of Spread Salt Square Meter, g/m^2", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "g/m^2"},
+		168: {No: "168", PropertyName: "Battery Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "6553", Multiplier: "-", Units: "V", Description: "Battery Voltage, V", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "V"},
+		169: {No: "169", PropertyName: "Spread Fine Grained Salt", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "T", Description: "Amount Of Spread Fine Grained Salt, T", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "T"},
+		170: {No: "170", PropertyName: "Coarse Grained Salt", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "T", Description: "Amount Of Coarse Grained Salt, T", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "T"},
+		171: {No: "171", PropertyName: "Spread DiMix", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "T", Description: "Amount Of Spread DiMix, T", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "T"},
+		172: {No: "172", PropertyName: "Spread Coarse Grained Calcium", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "m^3", Description: "Amount Of Spread Coarse Grained Calcium, m^3", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m^3"},
+		173: {No: "173", PropertyName: "Spread Calcium Chloride", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "m^3", Description: "Amount Of Spread Calcium Chloride, m^3", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m^3"},
+		174: {No: "174", PropertyName: "Spread Sodium Chloride", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "m^3", Description: "Amount Of Spread Sodium Chloride, m^3", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m^3"},
+		175: {No: "239", PropertyName: "Auto Geofence", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		176: {No: "175", PropertyName: "Spread Magnesium Chloride", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "m^3", Description: "Amount Of Spread Magnesium Chloride, m^3", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "m^3"},
+		177: {No: "176", PropertyName: "Amount Of Spread Gravel", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "T", Description: "Amount Of Spread Gravel, T", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "T"},
+		178: {No: "177", PropertyName: "Amount Of Spread Sand", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "T", Description: "Amount Of Spread Sand, T", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "T"},
+		179: {No: "20", PropertyName: "Digital Output 1", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		180: {No: "31", PropertyName: "Digital Output 2", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB100, FMB110, FMB120, FMB122", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		181: {No: "7", PropertyName: "GNSS PDOP", Bytes: "2", Type: "Unsigned", Min: "0", Max: "500", Multiplier: "0.1", Units: "-", Description: "Probability", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1},
+		182: {No: "8", PropertyName: "GNSS HDOP", Bytes: "2", Type: "Unsigned", Min: "0", Max: "500", Multiplier: "0.1", Units: "-", Description: "Probability", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1},
+		183: {No: "178", PropertyName: "Width Pouring Left", Bytes: "2", Type: "Unsigned", Min: "0", Max: "655", Multiplier: "-", Units: "m", Description: "Width Pouring Left, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "m"},
+		184: {No: "179", PropertyName: "Width Pouring Right", Bytes: "2", Type: "Unsigned", Min: "0", Max: "655", Multiplier: "-", Units: "m", Description: "Width Pouring Right, m", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "m"},
+		185: {No: "180", PropertyName: "Salt Spreader Working Hours", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "h", Description: "Salt Spreader Working Hours, h", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "h"},
+		186: {No: "181", PropertyName: "Distance During Salting", Bytes: "4", Type: "Unsigned", Min: "0", Max: "1677722", Multiplier: "-", Units: "km", Description: "Distance During Salting, km", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "km"},
+		187: {No: "182", PropertyName: "Load Weight", Bytes: "4", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "kg", Description: "Load Weight, kg", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "kg"},
+		188: {No: "183", PropertyName: "Retarder Load", Bytes: "1", Type: "Unsigned", Min: "0", Max: "130", Multiplier: "-", Units: "%", Description: "Retarder Load, %", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		189: {No: "184", PropertyName: "Cruise Time", Bytes: "4", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "min", Description: "Cruise Time, minutes", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint32", size: 4, units: "min"},
+		190: {No: "212", PropertyName: "Geofence zone 24", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		191: {No: "213", PropertyName: "Geofence zone 25", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		192: {No: "214", PropertyName: "Geofence zone 26", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		193: {No: "215", PropertyName: "Geofence zone 27", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		194: {No: "216", PropertyName: "Geofence zone 28", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		195: {No: "217", PropertyName: "Geofence zone 29", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		196: {No: "218", PropertyName: "Geofence zone 30", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		197: {No: "219", PropertyName: "Geofence zone 31", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		198: {No: "220", PropertyName: "Geofence zone 32", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		199: {No: "16", PropertyName: "Trip Odometer", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Trip Odometer value in meters", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250, GH5200", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		200: {No: "5", PropertyName: "Sleep Mode", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 - No Sleep 1 – GPS Sleep 2 – Deep Sleep 3 – Online Sleep", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "No Sleep", "1": "GPS Sleep", "2": "Deep Sleep", "3": "Online Sleep"}, FinalConversion: "toUint8", size: 1},
+		201: {No: "42", PropertyName: "LLS 1 Fuel Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "kvants or ltr"},
+		202: {No: "43", PropertyName: "LLS 1 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		203: {No: "44", PropertyName: "LLS 2 Fuel Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "kvants or ltr"},
+		204: {No: "45", PropertyName: "LLS 2 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		205: {No: "11", PropertyName: "GSM Cell ID", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "-", Description: "GSM base station ID", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2},
+		206: {No: "12", PropertyName: "GSM Area Code", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "-", Description: "Location Area code (LAC), it depends on GSM operator. It provides unique number which assigned to a set of base GSM stations. Max value: 65536", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2},
+		207: {No: "41", PropertyName: "RFID", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "RFID ID", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		208: {No: "221", PropertyName: "Geofence zone 33", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		209: {No: "222", PropertyName: "Geofence zone 34", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		210: {No: "46", PropertyName: "LLS 3 Fuel Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "kvants or ltr"},
+		211: {No: "47", PropertyName: "LLS 3 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		212: {No: "48", PropertyName: "LLS 4 Fuel Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "kvants or ltr"},
+		213: {No: "49", PropertyName: "LLS 4 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		214: {No: "50", PropertyName: "LLS 5 Fuel Level", Bytes: "2", Type: "Signed", Min: "0", Max: "65535", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "kvants or ltr"},
+		215: {No: "51", PropertyName: "LLS 5 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB125", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		216: {No: "223", PropertyName: "Geofence zone 35", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		217: {No: "224", PropertyName: "Geofence zone 36", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		218: {No: "225", PropertyName: "Geofence zone 37", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		219: {No: "226", PropertyName: "Geofence zone 38", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		220: {No: "227", PropertyName: "Geofence zone 39", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		221: {No: "228", PropertyName: "Geofence zone 40", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		222: {No: "229", PropertyName: "Geofence zone 41", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		223: {No: "230", PropertyName: "Geofence zone 42", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		224: {No: "231", PropertyName: "Geofence zone 43", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		225: {No: "232", PropertyName: "Geofence zone 44", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		226: {No: "233", PropertyName: "Geofence zone 45", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		227: {No: "234", PropertyName: "Geofence zone 46", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		228: {No: "235", PropertyName: "Geofence zone 47", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		229: {No: "236", PropertyName: "Geofence zone 48", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		230: {No: "237", PropertyName: "Geofence zone 49", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		231: {No: "238", PropertyName: "Geofence zone 50", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone", "2": "Over Speeding End", "3": "Over Speeding Start"}, FinalConversion: "toUint8", size: 1},
+		232: {No: "185", PropertyName: "CNG Status", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "CNG Status", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1},
+		233: {No: "186", PropertyName: "CNG Used", Bytes: "2", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "kg", Description: "CNG Used", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		234: {No: "187", PropertyName: "CNG Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "%", Description: "CNG Level", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint16", size: 2, units: "%"},
+		235: {No: "188", PropertyName: "Engine Oil Level", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", Description: "Oil Level", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "LVCAN elements", FinalConversion: "toUint8", size: 1},
+		236: {No: "252", PropertyName: "Alarm", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – Reserved 1 – Alarm event occurred", HWSupport: "TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Reserved", "1": "Alarm Event Occurred"}, FinalConversion: "toUint8", size: 1},
+		237: {No: "68", PropertyName: "Network Type", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - 3G 1 - 2G", HWSupport: "FM3001, FM3010", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "3G", "1": "2G"}, FinalConversion: "toUint8", size: 1},
+		238: {No: "55", PropertyName: "User ID", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "MAC address of NMEA receiver device connected via Bluetooth", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		239: {No: "1", PropertyName: "Ignition", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Ignition Off 1 - Ignition On", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Ignition Off", "1": "Ignition On"}, FinalConversion: "toUint8", size: 1},
+		240: {No: "2", PropertyName: "Movement", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Movement Off 1 - Movement On", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Movement Off", "1": "Movement On"}, FinalConversion: "toUint8", size: 1},
+		241: {No: "15", PropertyName: "Active GSM Operator", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Currently used GSM Operator code", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		242: {No: "253", PropertyName: "ManDown", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – ManDown diactivated 1 – ManDown is acive", HWSupport: "TMT250", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "ManDown Deactivated", "1": "ManDown Is Active"}, FinalConversion: "toUint8", size: 1},
+		243: {No: "251", PropertyName: "Green driving event duration", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "ms", Description: "Duration of event that did generate Green Driving", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint16", size: 2, units: "ms"},
+		244: {No: "255", PropertyName: "DIN2/AIN2 spec event", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "deg/s", Description: "Generates after spec DIN2/AIN2 scenario", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1, units: "deg/s"},
+		245: {No: "254", PropertyName: "Gyroscope axis", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xFFFFFF00", Multiplier: "-", Units: "deg/s", Description: "Gyroscope axis data 8 bytes 1st byte - Z axis, 2nd byte - Y axis, 3rd byte - X axis, 4th byte - empty (0x00) records with gyro IO element will be made during crash event. Codec61 protocol required.", HWSupport: "All hardware with LSM6DSL gyroscope", ParametrGroup: "Eventual I/O elements", FinalConversion: "to[]byte", size: 8, units: "deg/s"},
+		246: {No: "244", PropertyName: "Towing", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – steady 1 – towing", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Steady", "1": "Towing"}, FinalConversion: "toUint8", size: 1},
+		247: {No: "246", PropertyName: "Crash detection", Bytes: "1", Type: "Unsigned", Min: "1", Max: "2", Multiplier: "-", Units: "-", Description: "1 – crash 2 – limited crash trace (device not calibrated) 3 - limited crash trace (device is calibrated) 4 - full crash trace (device not calibrated) 5 - full crash trace (device is calibrated)", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"1": "Crash", "2": "Limited Crash Trace (Device Not Calibrated)", "3": "Limited Crash Trace (Device Is Calibrated)", "4": "Full Crash Trace (Device Not Calibrated)", "5": "Full Crash Trace (Device Is Calibrated)"}, FinalConversion: "toUint8", size: 1},
+		248: {No: "247", PropertyName: "Immobilizer", Bytes: "1", Type: "Unsigned", Min: "0", Max: "2", Multiplier: "-", Units: "-", Description: "0 – iButton not connected 1 – iButton connected (Immobilizer) 2 – iButton connected (Authorized Driving)", HWSupport: "FMB100, FMB110, FMB120, FMB122, FMB125", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "iButton Not Connected", "1": "iButton Connected (Immobilizer)", "2": "iButton Connected (Authorized Driving)"}, FinalConversion: "toUint8", size: 1},
+		249: {No: "249", PropertyName: "Jamming", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "1 - jamming start 0 - jamming stop", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Jamming Stop", "1": "Jamming Start"}, FinalConversion: "toUint8", size: 1},
+		250: {No: "240", PropertyName: "Trip", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "1 – trip start, 0 – trip stop. From 01.00.24 fw version available with BT app new values: 2 – Business Status; 3 – Private Status; 4-9 – Custom Statuses", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Trip Stop", "1": "Trip Start", "2": "Business Status", "3": "Private Status", "4": "Custom Status", "5": "Custom Status", "6": "Custom Status", "7": "Custom Status", "8": "Custom Status", "9": "Custom Status"}, FinalConversion: "toUint8", size: 1},
+		251: {No: "242", PropertyName: "Idling", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - moving 1 - idling", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Moving", "1": "Idling"}, FinalConversion: "toUint8", size: 1},
+		252: {No: "245", PropertyName: "Unplug", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – battery present 1 – battery unpluged", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Battery Present", "1": "Battery Unplugged"}, FinalConversion: "toUint8", size: 1},
+		253: {No: "243", PropertyName: "Green driving type", Bytes: "1", Type: "Unsigned", Min: "1", Max: "3", Multiplier: "-", Units: "-", Description: "1 – harsh acceleration 2 – harsh braking 3 – harsh cornering", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"1": "Harsh Acceleration", "2": "Harsh Braking", "3": "Harsh Cornering"}, FinalConversion: "toUint8", size: 1},
+		254: {No: "248", PropertyName: "Green driving value", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0.01", Units: "G or rad", Description: "Depending on green driving type: if harsh acceleration or braking – g*100 (value 123 -> 1.23g), if harsh cornering – degrees (value in radians)", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 0.01, units: "G or rad"},
+		255: {No: "241", PropertyName: "Over Speeding", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "km/h", Description: "At over speeding start km/h, at over speeding end km/h", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1, units: "km/h"},
+		256: {No: "74", PropertyName: "VIN", Bytes: "17", Type: "String", Min: "0", Max: "0xff", Multiplier: "-", Units: "-", Description: "VIN number", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements", FinalConversion: "toString", size: 17},
+		281: {No: "256", PropertyName: "Fault Codes", Bytes: "Variable", Type: "String", Min: "0", Max: "0xff", Multiplier: "-", Units: "-", Description: "Fault Codes (values separated via ,)", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010", ParametrGroup: "OBD elements"},
+		303: {No: "257", PropertyName: "Instant Movement", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Movement Stop 1 - Movement Start", HWSupport: "FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Movement Stop", "1": "Movement Start"}, FinalConversion: "toUint8", size: 1},
+		381: {No: "258", PropertyName: "Ground Sense", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB130", ParametrGroup: "Permanent I/O elements", size: 1},
+	},
+	FamilyFM64: {
+		1:     {PropertyName: "Digital Input 1", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		2:     {PropertyName: "Digital Input 2", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		3:     {PropertyName: "Digital Input 3", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		4:     {PropertyName: "Digital Input 4", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		5:     {PropertyName: "Dallas Temperature ID 5", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		6:     {PropertyName: "Dallas Temperature 5", Bytes: "2", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0,1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		7:     {PropertyName: "Dallas Temperature ID 5", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		8:     {PropertyName: "Dallas Temperature 5", Bytes: "2", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0,1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		9:     {PropertyName: "Analog Input 1", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "0,001", Units: "V", Description: "Voltage, V", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		10:    {PropertyName: "Analog Input 2", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "0,001", Units: "V", Description: "Voltage, V", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		11:    {PropertyName: "Analog Input 3", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "0,001", Units: "V", Description: "Voltage, V", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		12:    {PropertyName: "Program Number", Bytes: "4", Type: "Unsigned", Min: "0", Max: "999", Multiplier: "-", Units: "-", Description: "Value: Min – 0, Max – 999", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4},
+		13:    {PropertyName: "Module ID", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Module ID", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "to[]byte", size: 8},
+		14:    {PropertyName: "Engine Worktime", Bytes: "4", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "min", Description: "Engine work time in minutes", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "min"},
+		15:    {PropertyName: "Engine Worktime (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "min", Description: "Total Engine work time in minutes", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "min"},
+		16:    {PropertyName: "Total Mileage (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Total Vehicle Mileage, m", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		17:    {PropertyName: "Fuel Consumed (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "2147483647", Multiplier: "0,1", Units: "l", Description: "Total Fuel Consumed, l", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "l"},
+		18:    {PropertyName: "Fuel Rate", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "0,1", Units: "l/h", Description: "Fuel Rata, l/h", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "l/h"},
+		19:    {PropertyName: "AdBlue Level Percent", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0,1", Units: "%", Description: "AdBlue, %", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		20:    {PropertyName: "AdBlue Level Liters", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0,1", Units: "l", Description: "AdBlue level, L", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "l"},
+		21:    {PropertyName: "GSM Signal", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "Value in range 1-5", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		22:    {PropertyName: "Data Mode", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "0 – Home On Stop 1 – Home On Moving 2 – Roaming On Stop 3 – Roaming On Moving 4 – Unknown On Stop 5 – Unknown On Moving", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Home On Stop", "1": "Home On Moving", "2": "Roaming On Stop", "3": "Roaming On Moving", "4": "Unknown On Stop", "5": "Unknown On Moving"}, FinalConversion: "toUint8", size: 1},
+		23:    {PropertyName: "Engine Load", Bytes: "1", Type: "Unsigned", Min: "0", Max: "130", Multiplier: "-", Units: "%", Description: "Engine load, %", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		24:    {PropertyName: "Speed", Bytes: "2", Type: "Unsigned", Min: "0", Max: "350", Multiplier: "-", Units: "km/h", Description: "Value, km/h", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "km/h"},
+		25:    {PropertyName: "Engine Temperature", Bytes: "2", Type: "Signed", Min: "-600", Max: "1270", Multiplier: "0,1", Units: "°C", Description: "Engine Temperature, °C", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		26:    {PropertyName: "Axle 1 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 1 load, kg", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		27:    {PropertyName: "Axle 2 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 2 load, kg", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		28:    {PropertyName: "Axle 3 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 3 load, kg", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		29:    {PropertyName: "Axle 4 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 4 load, kg", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		30:    {PropertyName: "Vehicle Speed", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "km/h", Description: "Value in km/h", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint8", size: 1, units: "km/h"},
+		31:    {PropertyName: "Accelerator Pedal Position", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0,1", Units: "%", Description: "Value in persentages, %", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		32:    {PropertyName: "Axle 5 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32768", Multiplier: "-", Units: "kg", Description: "Axle 5 load, kg", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		33:    {PropertyName: "Fuel Consumed", Bytes: "4", Type: "Unsigned", Min: "0", Max: "2147483647", Multiplier: "0,1", Units: "l", Description: "Value in liters, L", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "l"},
+		34:    {PropertyName: "Fuel Level Liters", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0,1", Units: "l", Description: "Value in liters, L", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "l"},
+		35:    {PropertyName: "Engine RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "16384", Multiplier: "-", Units: "rpm", Description: "Value in rounds per minute, rpm", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, units: "rpm"},
+		36:    {PropertyName: "Total Mileage", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Value in meters, m", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		37:    {PropertyName: "Fuel Level Percent", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0,1", Units: "%", Description: "Value in percentages, %", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		38:    {PropertyName: "Control State Flags", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Control state flags", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", Bits: map[string]string{"0": "STOP", "1": "Oil pressure / level", "2": "Coolant liquid temperature / level", "3": "Handbrake system", "4": "Battery charging", "5": "AIRBAG", "8": "CHECK ENGINE", "9": "Lights failure", "10": "Low tire pressure", "11": "Wear of brake pads", "12": "Warning", "13": "ABS", "14": "Low Fuel", "16": "ESP", "17": "Glow plug indicator", "18": "FAP", "19": "Electronics pressure control", "20": "Parking lights", "21": "Dipped headlights", "22": "Full beam headlights", "30": "Passenger's seat belt", "31": "Driver's seat belt"}, FinalConversion: "toUint32", size: 4},
+		39:    {PropertyName: "Agricultural Machinery Flags", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Agricultural machinery flags", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", Bits: map[string]string{"0": "Mowing", "1": "Grain release from hopper", "2": "First front hydraulic turned on", "3": "Rear Power Take-Off turned on", "8": "Excessive play under the threshing drum", "9": "Grain tank is open", "10": "100% of Grain tank", "11": "70% of Grain tank", "12": "Drain filter in hydraulic system of drive cylinders is plugged", "13": "Pressure filter of drive cylinders hydraulic system is plugged", "14": "Alarm oil level in oil tank", "15": "Pressure filter of brakes hydraulic system is plugged", "16": "Oil filter of engine is plugged", "17": "Fuel filter is plugged", "18": "Air filter is plugged", "19": "Alarm oil temperature in hydraulic system of chasis", "20": "Alarm oil temperature in hydraulic system of drive cylinders", "21": "Alarm oil pressure in engine", "22": "Alarm coolant level", "23": "Overflow chamber of hydraulic unit", "24": "Unloader drive is ON", "25": "No operator", "26": "Straw walker is plugged", "27": "Water in fuel", "28": "Cleaning fan RPM", "29": "Trashing drum RPM", "33": "Low water level in the tank", "34": "First rear hydraulic turned on", "35": "Standalone engine working", "36": "Right joystick moved right", "37": "Right joystick moved left", "38": "Right joystick moved front", "39": "Right joystick moved back", "40": "Brushes turned on", "41": "Water supply turned on", "42": "Vacuum cleaner", "43": "Unloading from the hopper", "44": "High Pressure washer (Karcher)", "45": "Salt (sand) disperser ON", "46": "Low salt (sand) level", "48": "Second front hydraulic turned on", "49": "Third front hydraulic turned on", "50": "Fourth front hydraulic turned on", "51": "Second rear hydraulic turned on", "52": "Third rear hydraulic turned on", "53": "Fourth rear hydraulic turned on", "54": "Front three-point Hitch turned on", "55": "Rear three-point Hitch turned on", "56": "Left joystick moved right", "57": "Left joystick moved left", "58": "Left joystick moved front", "59": "Left joystick moved back", "60": "Front Power Take-Off turned on"}, FinalConversion: "to[]byte", size: 8},
+		40:    {PropertyName: "Harvesting Time", Bytes: "4", Type: "Unsigned", Min: "0", Max: "16777215", Multiplier: "-", Units: "min", Description: "Harvesting Time, minutes", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "min"},
+		41:    {PropertyName: "Area of Harvest", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m2", Description: "Area of Harvest, m^2", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "m2"},
+		42:    {PropertyName: "Mowing Efficiency", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m2/h", Description: "Mowing efficiency, (m^2)/h", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "m2/h"},
+		43:    {PropertyName: "Grain Mown Volume", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "kg", Description: "Mown Volume, kg", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "kg"},
+		44:    {PropertyName: "Grain Moisture", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0,1", Units: "%", Description: "Grain Moisture in proc, %", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "%"},
+		45:    {PropertyName: "Harvesting Drum RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "rpm", Description: "Harvesting Drum RPM, RPM", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, units: "rpm"},
+		46:    {PropertyName: "Gap Under Harvesting Drum", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "mm", Description: "Gap Under Harvesting Drum, mm", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint8", size: 1, units: "mm"},
+		47:    {PropertyName: "Security State Flags", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Security State Flag", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", Bits: map[string]string{"0": "CAN1 connected", "1": "CAN1 required", "2": "CAN2 connected", "3": "CAN2 required", "4": "CAN3 connected", "5": "CAN3 required", "6": "CAN4 connected", "7": "CAN4 required", "21": "Operate button pressed", "22": "Immobilizer in service mode", "23": "Immobilizer key sequence", "24": "Key in ignition lock", "25": "Ignition on", "26": "Dynamic ignition on", "27": "Webasto", "29": "Car closed by factory remote control", "30": "Factory alarm actuated", "31": "Factory alarm emulated by module", "32": "Parking activated", "36": "Handbrake actuated", "37": "Footbrake actuated", "38": "Engine working", "39": "Reverse on", "40": "Front left door open", "41": "Front right door open", "42": "Rear left door open", "43": "Rear right door open", "44": "Hood open", "45": "Trunk open", "55": "CAN module sleep mode"}, FinalConversion: "to[]byte", size: 8},
+		48:    {PropertyName: "Tacho Data Source", Bytes: "1", Type: "Unsigned", Min: "0", Max: "4", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		50:    {PropertyName: "Digital Output 3", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		51:    {PropertyName: "Digital Output 4", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		56:    {PropertyName: "Driver 1 Continuous Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "0xffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2},
+		57:    {PropertyName: "Driver 2 Continuous Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "0xffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2},
+		58:    {PropertyName: "Driver 1 Cumulative Break Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "0xffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2},
+		59:    {PropertyName: "Driver 2 Cumulative Break Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "0xffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2},
+		60:    {PropertyName: "Driver 1 Selected Activity Duration", Bytes: "2", Type: "Unsigned", Min: "0", Max: "0xffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2},
+		61:    {PropertyName: "Driver 2 Selected Activity Duration", Bytes: "2", Type: "Unsigned", Min: "0", Max: "0xffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2},
+		62:    {PropertyName: "Dallas Temperature ID 1", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		63:    {PropertyName: "Dallas Temperature ID 2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		64:    {PropertyName: "Dallas Temperature ID 3", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		65:    {PropertyName: "Dallas Temperature ID 4", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Dallas sensor ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		66:    {PropertyName: "External Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "0,001", Units: "V", Description: "Voltage, mV", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		67:    {PropertyName: "Battery Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "0,001", Units: "V", Description: "Voltage, mV", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		68:    {PropertyName: "Battery Current", Bytes: "2", Type: "Unsigned", Min: "0", Max: "2400", Multiplier: "-", Units: "mA", Description: "Current, mA", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mA"},
+		69:    {PropertyName: "Driver 1 Cumulative Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "0xffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2},
+		70:    {PropertyName: "PCB Temperature", Bytes: "2", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0,1", Units: "°C", Description: "Degrees ( °C )", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		71:    {PropertyName: "GNSS Status", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "0 - GNSS OFF 1 - GNSS ON, no GPS antena 2 - GNSS ON, without fix 3 - GNSS ON, with fix 4 - GNSS SLEEP 5 - GNSS Overcurrent/protect state", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "GNSS OFF", "1": "GNSS ON, No GPS Antena", "2": "GNSS ON, Without Fix", "3": "GNSS ON, With Fix", "4": "GNSS SLEEP", "5": "GNSS Overcurrent/Protect State"}, FinalConversion: "toUint8", size: 1},
+		72:    {PropertyName: "Dallas Temperature 1", Bytes: "2", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0,1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		73:    {PropertyName: "Dallas Temperature 2", Bytes: "2", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0,1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		74:    {PropertyName: "Dallas Temperature 3", Bytes: "2", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0,1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		75:    {PropertyName: "Dallas Temperature 4", Bytes: "2", Type: "Signed", Min: "-550", Max: "1150", Multiplier: "0,1", Units: "°C", Description: "Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		76:    {PropertyName: "Fuel Counter", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Difference of generated impulses on two signal lines", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		77:    {PropertyName: "Driver 2 Cumulative Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "0xffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2},
+		78:    {PropertyName: "iButton", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "iButton ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		79:    {PropertyName: "Brake Switch", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Pedal released 1 - Pedal pressed", HWSupport: "FMB640", ParametrGroup: "FMS elements", Values: map[string]string{"0": "Pedal Released", "1": "Pedal Pressed"}, FinalConversion: "toUint8", size: 1},
+		80:    {PropertyName: "Wheel Based Speed", Bytes: "4", Type: "Unsigned", Min: "0", Max: "65536", Multiplier: "-", Units: "km/h", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "km/h"},
+		81:    {PropertyName: "Cruise Control Active", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Switched off 1 - Switched on", HWSupport: "FMB640", ParametrGroup: "FMS elements", Values: map[string]string{"0": "Switched Off", "1": "Switched On"}, FinalConversion: "toUint8", size: 1},
+		82:    {PropertyName: "Clutch Switch", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Pedal released 1 - Pedal pressed", HWSupport: "FMB640", ParametrGroup: "FMS elements", Values: map[string]string{"0": "Pedal Released", "1": "Pedal Pressed"}, FinalConversion: "toUint8", size: 1},
+		83:    {PropertyName: "PTO State", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Off/disabled 1 - Set 2 - Not available", HWSupport: "FMB640", ParametrGroup: "FMS elements", Values: map[string]string{"0": "Off/Disabled", "1": "Set", "2": "Not Available"}, FinalConversion: "toUint8", size: 1},
+		84:    {PropertyName: "Acceleration Pedal Position", Bytes: "4", Type: "Unsigned", Min: "0", Max: "102", Multiplier: "-", Units: "%", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "%"},
+		85:    {PropertyName: "Engine Current Load", Bytes: "1", Type: "Unsigned", Min: "0", Max: "125", Multiplier: "-", Units: "%", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint8", size: 1, units: "%"},
+		86:    {PropertyName: "Engine Total Fuel Used", Bytes: "4", Type: "Unsigned", Min: "0", Max: "2105540607", Multiplier: "-", Units: "l", Description: "Value in liters, L", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "l"},
+		87:    {PropertyName: "Fuel Level", Bytes: "4", Type: "Unsigned", Min: "0", Max: "102", Multiplier: "-", Units: "%", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "%"},
+		88:    {PropertyName: "Engine Speed", Bytes: "4", Type: "Unsigned", Min: "0", Max: "8032", Multiplier: "-", Units: "rpm", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "rpm"},
+		89:    {PropertyName: "Axle weight 1", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		90:    {PropertyName: "Axle weight 2", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		91:    {PropertyName: "Axle weight 3", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		92:    {PropertyName: "Axle weight 4", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		93:    {PropertyName: "Axle weight 5", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		94:    {PropertyName: "Axle weight 6", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		95:    {PropertyName: "Axle weight 7", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		96:    {PropertyName: "Axle weight 8", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		97:    {PropertyName: "Axle weight 9", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		98:    {PropertyName: "Axle weight 10", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		99:    {PropertyName: "Axle weight 11", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		100:   {PropertyName: "Axle weight 12", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		101:   {PropertyName: "Axle weight 13", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		102:   {PropertyName: "Axle weight 14", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		103:   {PropertyName: "Axle weight 15", Bytes: "2", Type: "Unsigned", Min: "0", Max: "32766", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint16", size: 2, units: "kg"},
+		104:   {PropertyName: "Engine Total Hours Of Operation", Bytes: "4", Type: "Unsigned", Min: "0", Max: "214748364", Multiplier: "-", Units: "h", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "h"},
+		108:   {PropertyName: "LVCAN Driver2 ID High", Bytes: "8", Type: "Unsigned", Min: "-", Max: "-", Multiplier: "-", Units: "-", Description: "Driver2 ID High", HWSupport: "FMB640", ParametrGroup: "LVCAN", FinalConversion: "to[]byte", size: 8},
+		109:   {PropertyName: "SW-version supported", Bytes: "4", Type: "Unsigned", Min: "-", Max: "-", Multiplier: "-", Units: "-", Description: "4 ASCII bytes (Version format – ab.cd)", HWSupport: "FMB640", ParametrGroup: "LVCAN", FinalConversion: "to[]byte", size: 4},
+		110:   {PropertyName: "Diagnostics Supported", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 - Diagnostics is not supported 1 - Diagnostics is supported 2 - Reserved 3 - Do not care", HWSupport: "FMB640", ParametrGroup: "FMS elements", Values: map[string]string{"0": "Diagnostics Is Not Supported", "1": "Diagnostics Is Supported", "2": "Reserved", "3": "Do Not Care"}, FinalConversion: "toUint8", size: 1},
+		111:   {PropertyName: "Requests supported", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 – On request mode is Not supported; 1– On request mode is Supported; 2 – reserved; 3 – Not available;", HWSupport: "FMB640", ParametrGroup: "FMS elements", Values: map[string]string{"0": "On Request Mode Is Not Supported", "1": "On Request Mode Is Supported", "2": "Reserved", "3": "Not Available"}, FinalConversion: "toUint8", size: 1},
+		113:   {PropertyName: "Service Distance", Bytes: "4", Type: "Signed", Min: "-160635", Max: "167040", Multiplier: "-", Units: "km", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toInt32", size: 4, signed: true, units: "km"},
+		122:   {PropertyName: "Direction Indication", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint8", size: 1},
+		123:   {PropertyName: "Tachograph Performance", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint8", size: 1},
+		124:   {PropertyName: "Handling Info", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint8", size: 1},
+		125:   {PropertyName: "System Event", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint8", size: 1},
+		127:   {PropertyName: "Engine Coolant Temperature", Bytes: "1", Type: "Signed", Min: "-40", Max: "210", Multiplier: "-", Units: "°C", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		128:   {PropertyName: "Ambient Air Temperature", Bytes: "2", Type: "Signed", Min: "-273", Max: "1770", Multiplier: "-", Units: "°C", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toInt16", size: 2, signed: true, units: "°C"},
+		135:   {PropertyName: "Fuel Rate", Bytes: "4", Type: "Unsigned", Min: "0", Max: "3212", Multiplier: "-", Units: "l/h", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "l/h"},
+		136:   {PropertyName: "Instantaneous Fuel Economy", Bytes: "4", Type: "Unsigned", Min: "0", Max: "125", Multiplier: "-", Units: "km/l", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "km/l"},
+		137:   {PropertyName: "PTO Drive Engagement", Bytes: "1", Type: "Unsigned", Min: "0", Max: "3", Multiplier: "-", Units: "-", Description: "0 - No PTO drive is engaged 1 - At least one PTO drive is engaged 2 - Error 3 - Not available", HWSupport: "FMB640", ParametrGroup: "FMS elements", Values: map[string]string{"0": "No PTO Drive Is Engaged", "1": "At Least One PTO Drive Is Engaged", "2": "Error", "3": "Not Available"}, FinalConversion: "toUint8", size: 1},
+		138:   {PropertyName: "High Resolution Engine Total Fuel Used", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4211081.215 l", Multiplier: "-", Units: "l or ml", Description: "Resolution in l or ml depending on the FMS fuel settings (item id 121)", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "l or ml"},
+		139:   {PropertyName: "Gross Combination Vehicle Weight", Bytes: "4", Type: "Unsigned", Min: "0", Max: "642550", Multiplier: "-", Units: "kg", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "kg"},
+		140:   {PropertyName: "LVCAN Driver2 ID Low", Bytes: "8", Type: "Unsigned", Min: "-", Max: "-", Multiplier: "-", Units: "-", Description: "Driver2 ID Low", HWSupport: "FMB640", ParametrGroup: "LVCAN", FinalConversion: "to[]byte", size: 8},
+		141:   {PropertyName: "Battery Temperature", Bytes: "2", Type: "Signed", Min: "-600", Max: "1270", Multiplier: "0,1", Units: "°C", Description: "Degrees, °C", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		142:   {PropertyName: "Battery Level Percent", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0,1", Units: "%", Description: "Value in percentages, %", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		143:   {PropertyName: "Door Status", Bytes: "2", Type: "Unsigned", Min: "0", Max: "16128", Multiplier: "-", Units: "-", Description: "Min – 0, Max – 16128 Door status is represented as bitmask converted to decimal value. Possible values: 0 – all doors closed,0x100 (256) – front left door is opened,0x200 (512) – front right door is opened,0x400 (1024) – rear left door is opened,0x800 (2048) – rear right door is opened,0x1000 (4096) – hood is opened,0x2000 (8192) – trunk is opened,0x3F00 (16128) – all doors are opened, or combinations of values", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", Bits: map[string]string{"8": "Front left door open", "9": "Front right door open", "10": "Rear left door open", "11": "Rear right door open", "12": "Hood open", "13": "Trunk open"}, FinalConversion: "toUint16", size: 2},
+		144:   {PropertyName: "SD Status", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0 – not present, 1 – present", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Not Present", "1": "Present"}, FinalConversion: "toUint8", size: 1},
+		145:   {PropertyName: "Manual CAN 00", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		146:   {PropertyName: "Manual CAN 01", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		147:   {PropertyName: "Manual CAN 02", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		148:   {PropertyName: "Manual CAN 03", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		149:   {PropertyName: "Manual CAN 04", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		150:   {PropertyName: "Manual CAN 05", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		151:   {PropertyName: "Manual CAN 06", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		152:   {PropertyName: "Manual CAN 07", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		153:   {PropertyName: "Manual CAN 08", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		154:   {PropertyName: "Manual CAN 09", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		155:   {PropertyName: "Geofence zone 01", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		156:   {PropertyName: "Geofence zone 02", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		157:   {PropertyName: "Geofence zone 03", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		158:   {PropertyName: "Geofence zone 04", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		159:   {PropertyName: "Geofence zone 05", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		160:   {PropertyName: "Geofence zone 06", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		161:   {PropertyName: "Geofence zone 07", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		162:   {PropertyName: "Geofence zone 08", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		163:   {PropertyName: "Geofence zone 09", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		164:   {PropertyName: "Geofence zone 10", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		165:   {PropertyName: "Geofence zone 11", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		166:   {PropertyName: "Geofence zone 12", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		167:   {PropertyName: "Geofence zone 13", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		168:   {PropertyName: "Geofence zone 14", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		169:   {PropertyName: "Geofence zone 15", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		170:   {PropertyName: "Geofence zone 16", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		171:   {PropertyName: "Geofence zone 17", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		172:   {PropertyName: "Geofence zone 18", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		173:   {PropertyName: "Geofence zone 19", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		174:   {PropertyName: "Geofence zone 20", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		175:   {PropertyName: "Auto Geofence", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		176:   {PropertyName: "DTC Errors", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", Description: "DTC Errors", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint8", size: 1},
+		177:   {PropertyName: "DTC Codes", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "to[]byte", size: 8},
+		178:   {PropertyName: "Network Type", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - 3G 1 - 2G", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "3G", "1": "2G"}, FinalConversion: "toUint8", size: 1},
+		179:   {PropertyName: "Digital Output 1", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		180:   {PropertyName: "Digital Output 2", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0/1", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		181:   {PropertyName: "GNSS PDOP", Bytes: "2", Type: "Unsigned", Min: "0", Max: "500", Multiplier: "-", Units: "-", Description: "Probability", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2},
+		182:   {PropertyName: "GNSS HDOP", Bytes: "2", Type: "Unsigned", Min: "0", Max: "500", Multiplier: "-", Units: "-", Description: "Probability", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2},
+		183:   {PropertyName: "Drive Recognize", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		184:   {PropertyName: "Driver 1 Working State", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "0 - Rest; 1 - Driver available 2 - Work; 3 - Drive;  4 - Error; 5 - Not available.", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", Values: map[string]string{"0": "Rest", "1": "Driver Available", "2": "Work", "3": "Drive", "4": "Error", "5": "Not Available"}, FinalConversion: "toUint8", size: 1},
+		185:   {PropertyName: "Driver 2 Working State", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "0 - Rest; 1 - Driver available 2 - Work; 3 - Drive; 4 - Error; 5 - Not available.", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", Values: map[string]string{"0": "Rest", "1": "Driver Available", "2": "Work", "3": "Drive", "4": "Error", "5": "Not Available"}, FinalConversion: "toUint8", size: 1},
+		186:   {PropertyName: "Tachograph Over Speed", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		187:   {PropertyName: "Driver 1 Card Presence", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		188:   {PropertyName: "Driver 2 Card Presence", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		189:   {PropertyName: "Driver 1 Time Related States", Bytes: "1", Type: "Unsigned", Min: "0", Max: "15", Multiplier: "-", Units: "-", Description: "0 – normal; 1 – 15 min before 4.5h; 2 – 4.5h reached; 3 – 15 min before 9h; 4 – 9 h reached; 5 – 15 min before 16h; 6 – 16h reached; 7 – 12 reserved; 13 – Other; 14 – Error; 15 – Not available.", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		190:   {PropertyName: "Driver 2 Time Related States", Bytes: "1", Type: "Unsigned", Min: "0", Max: "15", Multiplier: "-", Units: "-", Description: "0 – normal; 1 – 15 min before 4.5h; 2 – 4.5h reached; 3 – 15 min before 9h; 4 – 9 h reached; 5 – 15 min before 16h; 6 – 16h reached; 7 – 12 reserved; 13 – Other; 14 – Error; 15 – Not available.", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		191:   {PropertyName: "Vehicle Speed", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "km/h", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint16", size: 2, units: "km/h"},
+		192:   {PropertyName: "Odometer", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Total vehicle distance", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		193:   {PropertyName: "Trip Distance", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Current vehicle distance", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		194:   {PropertyName: "Timestamp", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint32", size: 4},
+		195:   {PropertyName: "Driver 1 ID MSB", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "to[]byte", size: 8},
+		196:   {PropertyName: "Driver 1 ID LSB", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "to[]byte", size: 8},
+		197:   {PropertyName: "Driver 2 ID MSB", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "to[]byte", size: 8},
+		198:   {PropertyName: "Driver 2 ID LSB", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "to[]byte", size: 8},
+		199:   {PropertyName: "Trip Odometer", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Trip Odometer value in meters", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		200:   {PropertyName: "Sleep Mode", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Normal mode Deep Sleep", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Normal Mode", "1": "Deep Sleep"}, FinalConversion: "toUint8", size: 1},
+		201:   {PropertyName: "LLS 1 Fuel Level", Bytes: "2", Type: "Signed", Min: "-4", Max: "32767", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "kvants or ltr"},
+		202:   {PropertyName: "LLS 1 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		203:   {PropertyName: "LLS 2 Fuel Level", Bytes: "2", Type: "Signed", Min: "-4", Max: "32767", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "kvants or ltr"},
+		204:   {PropertyName: "LLS 2 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		205:   {PropertyName: "GSM Cell ID", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "GSM base station ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		206:   {PropertyName: "GSM Area Code", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "-", Description: "Location Area code (LAC), it depends on GSM operator. It provides unique number which assigned to a set of base GSM stations.", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2},
+		207:   {PropertyName: "RFID", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "RFID ID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		208:   {PropertyName: "Ultrasonic Software Status 1", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		209:   {PropertyName: "Ultrasonic Software Status 2", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		210:   {PropertyName: "LLS 3 Fuel Level", Bytes: "2", Type: "Signed", Min: "-4", Max: "32767", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "kvants or ltr"},
+		211:   {PropertyName: "LLS 3 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		212:   {PropertyName: "LLS 4 Fuel Level", Bytes: "2", Type: "Signed", Min: "-4", Max: "32767", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "kvants or ltr"},
+		213:   {PropertyName: "LLS 4 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		214:   {PropertyName: "LLS 5 Fuel Level", Bytes: "2", Type: "Signed", Min: "-4", Max: "32767", Multiplier: "-", Units: "kvants or ltr", Description: "Fuel level measured by LLS sensor via RS232 in kvants or liters", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "kvants or ltr"},
+		215:   {PropertyName: "LLS 5 Temperature", Bytes: "1", Type: "Signed", Min: "-128", Max: "127", Multiplier: "-", Units: "°C", Description: "Fuel temperature measured by LLS via RS232 in degrees Celsius", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt8", size: 1, signed: true, units: "°C"},
+		216:   {PropertyName: "Total Odometer", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Total Odometer value in meters", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		217:   {PropertyName: "RFID COM2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "RFID ID on COM2", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		218:   {PropertyName: "IMSI", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "International mobile subscriber identity", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		219:   {PropertyName: "CCID Part1", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Value of SIM ICCID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		220:   {PropertyName: "CCID Part2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Value of SIM ICCID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		221:   {PropertyName: "CCID Part3", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Value of SIM ICCID", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8},
+		222:   {PropertyName: "Card 1 Issuing Member State", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", Description: "NationNumeric as described in EEC 3821_85", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		223:   {PropertyName: "Card 2 Issuing Member State", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "-", Description: "NationNumeric as described in EEC 3821_85", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		224:   {PropertyName: "Ultrasonic Fuel Level 1", Bytes: "2", Type: "Signed", Min: "-15", Max: "32767", Multiplier: "0,1", Units: "mm", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "mm"},
+		225:   {PropertyName: "Ultrasonic Fuel Level 2", Bytes: "2", Type: "Signed", Min: "-15", Max: "32767", Multiplier: "0,1", Units: "mm", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "mm"},
+		226:   {PropertyName: "CNG Status", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Engine not on CNG; 1 - Engine on CNG.", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", Values: map[string]string{"0": "Engine Not On CNG", "1": "Engine On CNG"}, FinalConversion: "toUint8", size: 1},
+		227:   {PropertyName: "CNG Used", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "kg", Description: "CNG Used", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint32", size: 4, units: "kg"},
+		228:   {PropertyName: "CNG Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "%", Description: "CNG Level", HWSupport: "FMB640", ParametrGroup: "CAN adapters elements", FinalConversion: "toUint16", size: 2, units: "%"},
+		229:   {PropertyName: "LVCAN Driver1 ID High", Bytes: "8", Type: "Unsigned", Min: "-", Max: "-", Multiplier: "-", Units: "-", Description: "Driver1 ID High", HWSupport: "FMB640", ParametrGroup: "LVCAN", FinalConversion: "to[]byte", size: 8},
+		230:   {PropertyName: "LVCAN Driver1 ID Low", Bytes: "8", Type: "Unsigned", Min: "-", Max: "-", Multiplier: "-", Units: "-", Description: "Driver1 ID Low", HWSupport: "FMB640", ParametrGroup: "LVCAN", FinalConversion: "to[]byte", size: 8},
+		231:   {PropertyName: "Vehicle Registration Number Part1", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "to[]byte", size: 8},
+		232:   {PropertyName: "Vehicle Registration Number Part2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "to[]byte", size: 8},
+		233:   {PropertyName: "Vehicle Identification Number Part1", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "to[]byte", size: 8},
+		234:   {PropertyName: "Vehicle Identification Number Part2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "to[]byte", size: 8},
+		235:   {PropertyName: "Vehicle Identification Number Part3", Bytes: "1", Type: "Unsigned", Min: "0", Max: "0xff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Tachograph data elements", FinalConversion: "toUint8", size: 1},
+		236:   {PropertyName: "Axis X", Bytes: "2", Type: "Signed", Min: "-8000", Max: "8000", Multiplier: "-", Units: "mG", Description: "X axis value, mG", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "mG"},
+		237:   {PropertyName: "Axis Y", Bytes: "2", Type: "Signed", Min: "-8000", Max: "8000", Multiplier: "-", Units: "mG", Description: "Y axis value, mG", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "mG"},
+		238:   {PropertyName: "Axis Z", Bytes: "2", Type: "Signed", Min: "-8000", Max: "8000", Multiplier: "-", Units: "mG", Description: "Z axis value, mG", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "mG"},
+		239:   {PropertyName: "Ignition", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – Ignition Off 1 – Ignition On", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Ignition Off", "1": "Ignition On"}, FinalConversion: "toUint8", size: 1},
+		240:   {PropertyName: "Movement", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – Movement Off 1 – Movement On", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Movement Off", "1": "Movement On"}, FinalConversion: "toUint8", size: 1},
+		241:   {PropertyName: "Active GSM Operator", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "Currently used GSM Operator code", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		242:   {PropertyName: "Data Limit Hit", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Data limit hit in home; 1 - Data limit hit in roaming.", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Data Limit Hit In Home", "1": "Data Limit Hit In Roaming"}, FinalConversion: "toUint8", size: 1},
+		243:   {PropertyName: "Idling", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – moving, 1 – idling", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Moving", "1": "Idling"}, FinalConversion: "toUint8", size: 1},
+		245:   {PropertyName: "Analog Input 4", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "0,001", Units: "V", Description: "Voltage, V", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		246:   {PropertyName: "Towing", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – steady,1 – towing", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Steady", "1": "Towing"}, FinalConversion: "toUint8", size: 1},
+		247:   {PropertyName: "Crash Detection", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "1 – Crash detected 2 – limited crash trace (device not calibrated) 3 - limited crash trace (device is calibrated) 4 - full crash trace (device not calibrated) 5 - full crash trace (device is calibrated)", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"1": "Crash Detected", "2": "Limited Crash Trace (Device Not Calibrated)", "3": "Limited Crash Trace (Device Is Calibrated)", "4": "Full Crash Trace (Device Not Calibrated)", "5": "Full Crash Trace (Device Is Calibrated)"}, FinalConversion: "toUint8", size: 1},
+		248:   {PropertyName: "Geofence Zone Over Speeding", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1},
+		249:   {PropertyName: "Jamming", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "1 – jamming start 0 – jamming stop", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Jamming Stop", "1": "Jamming Start"}, FinalConversion: "toUint8", size: 1},
+		250:   {PropertyName: "Trip", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "1 – trip start; 0 – trip stop.", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Trip Stop", "1": "Trip Start"}, FinalConversion: "toUint8", size: 1},
+		251:   {PropertyName: "Immobilizer", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – iButton not connected 1 – iButton connected (Immobilizer) 2 – iButton connected (Authorized Driving)", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "iButton Not Connected", "1": "iButton Connected (Immobilizer)", "2": "iButton Connected (Authorized Driving)"}, FinalConversion: "toUint8", size: 1},
+		252:   {PropertyName: "Authorized Driving", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1},
+		253:   {PropertyName: "Green Driving Type", Bytes: "1", Type: "Unsigned", Min: "1", Max: "3", Multiplier: "-", Units: "-", Description: "1 – harsh acceleration, 2 – harsh braking, 3 – harsh cornering", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"1": "Harsh Acceleration", "2": "Harsh Braking", "3": "Harsh Cornering"}, FinalConversion: "toUint8", size: 1},
+		254:   {PropertyName: "Green Driving Value", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0.01", Units: "G or rad", Description: "Depending on green driving type: if harsh acceleration or braking – g*100 (value 123 -> 1.23g), if harsh cornering – degrees (value in radians)", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 0.01, units: "G or rad"},
+		255:   {PropertyName: "Over Speeding", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "km/h", Description: "At over speeding start km/h, at over speeding end km/h", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1, units: "km/h"},
+		288:   {PropertyName: "Sound Type", Bytes: "1", Type: "Unsigned", Min: "0", Max: "7", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		289:   {PropertyName: "Pedestrian In Danger Zone", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Pedestrian not in danger zone; 1 - Pedestrian in danger zone.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Pedestrian Not In Danger Zone", "1": "Pedestrian In Danger Zone"}, FinalConversion: "toUint8", size: 1},
+		290:   {PropertyName: "Pedestrian Forward Collision Warning", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - No forward collision warning; 1 - Forward collision warning.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "No Forward Collision Warning", "1": "Forward Collision Warning"}, FinalConversion: "toUint8", size: 1},
+		291:   {PropertyName: "Time Indicator", Bytes: "1", Type: "Unsigned", Min: "0", Max: "2", Multiplier: "-", Units: "-", Description: "0 - Day is indicated; 1 - Dusk is indicated; 2 - Night is indicated.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Day Is Indicated", "1": "Dusk Is Indicated", "2": "Night Is Indicated"}, FinalConversion: "toUint8", size: 1},
+		292:   {PropertyName: "Error Valid", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Error; 1 - No error.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Error", "1": "No Error"}, FinalConversion: "toUint8", size: 1},
+		293:   {PropertyName: "Error Code", Bytes: "1", Type: "Unsigned", Min: "0", Max: "127", Multiplier: "-", Units: "-", Description: "Error code", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		294:   {PropertyName: "Zero Speed", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		295:   {PropertyName: "Headway Valid", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		296:   {PropertyName: "Headway Measurement", Bytes: "1", Type: "Unsigned", Min: "0", Max: "9,9", Multiplier: "0,1", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1},
+		297:   {PropertyName: "LDW Off", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		298:   {PropertyName: "Left LDW On", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		299:   {PropertyName: "Right LDW On", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		300:   {PropertyName: "Maintanance", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		301:   {PropertyName: "Fail Safe", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		302:   {PropertyName: "FCW On", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		303:   {PropertyName: "TSR Enabled", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		304:   {PropertyName: "Headway Warning Repeat", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Off; 1 - On.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Off", "1": "On"}, FinalConversion: "toUint8", size: 1},
+		305:   {PropertyName: "Headway Warning Level", Bytes: "1", Type: "Unsigned", Min: "0", Max: "2", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		306:   {PropertyName: "TSR Warning Level", Bytes: "1", Type: "Unsigned", Min: "0", Max: "7", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		307:   {PropertyName: "Tamper Alert", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - No tamper alert; 1 - Tamper alert.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "No Tamper Alert", "1": "Tamper Alert"}, FinalConversion: "toUint8", size: 1},
+		308:   {PropertyName: "High Beam", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - No tamper alert; 1 - Tamper alert.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		309:   {PropertyName: "Low Beam", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Off; 1 - On.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Off", "1": "On"}, FinalConversion: "toUint8", size: 1},
+		310:   {PropertyName: "Wipers", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Off; 1 - On.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Off", "1": "On"}, FinalConversion: "toUint8", size: 1},
+		311:   {PropertyName: "Right Signal", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Off; 1 - On.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Off", "1": "On"}, FinalConversion: "toUint8", size: 1},
+		312:   {PropertyName: "Left Signal", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Off; 1 - On.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Off", "1": "On"}, FinalConversion: "toUint8", size: 1},
+		313:   {PropertyName: "Brake Signal", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 - Off; 1 - On.", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", Values: map[string]string{"0": "Off", "1": "On"}, FinalConversion: "toUint8", size: 1},
+		314:   {PropertyName: "Wipers Available", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		315:   {PropertyName: "Low Beam Available", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		316:   {PropertyName: "High Beam Available", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		317:   {PropertyName: "Speed Available", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1},
+		318:   {PropertyName: "Speed", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Units: "km/h", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "toUint8", size: 1, units: "km/h"},
+		319:   {PropertyName: "TSR 1", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Mobileye sign recognition data", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "to[]byte", size: 8},
+		320:   {PropertyName: "TSR 2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Mobileye sign recognition data", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "to[]byte", size: 8},
+		321:   {PropertyName: "TSR 3", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Mobileye sign recognition data", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "to[]byte", size: 8},
+		322:   {PropertyName: "TSR 4", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Mobileye sign recognition data", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "to[]byte", size: 8},
+		323:   {PropertyName: "TSR 5", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Mobileye sign recognition data", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "to[]byte", size: 8},
+		324:   {PropertyName: "TSR 6", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Mobileye sign recognition data", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "to[]byte", size: 8},
+		325:   {PropertyName: "TSR 7", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Mobileye sign recognition data", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "to[]byte", size: 8},
+		326:   {PropertyName: "TSR VO", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", Description: "Mobileye vision only sign type data", HWSupport: "FMB640", ParametrGroup: "Mobileye elements", FinalConversion: "to[]byte", size: 8},
+		327:   {PropertyName: "Geofence zone 21", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		328:   {PropertyName: "Geofence zone 22", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		329:   {PropertyName: "Geofence zone 23", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		330:   {PropertyName: "Geofence zone 24", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		331:   {PropertyName: "Geofence zone 25", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		332:   {PropertyName: "Geofence zone 26", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		333:   {PropertyName: "Geofence zone 27", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		334:   {PropertyName: "Geofence zone 28", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		335:   {PropertyName: "Geofence zone 29", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		336:   {PropertyName: "Geofence zone 30", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		337:   {PropertyName: "Geofence zone 31", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		338:   {PropertyName: "Geofence zone 32", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		339:   {PropertyName: "Geofence zone 33", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		340:   {PropertyName: "Geofence zone 34", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		341:   {PropertyName: "Geofence zone 35", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		342:   {PropertyName: "Geofence zone 36", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		343:   {PropertyName: "Geofence zone 37", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		344:   {PropertyName: "Geofence zone 38", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		345:   {PropertyName: "Geofence zone 39", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		346:   {PropertyName: "Geofence zone 40", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		347:   {PropertyName: "Geofence zone 41", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		348:   {PropertyName: "Geofence zone 42", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		349:   {PropertyName: "Geofence zone 43", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		350:   {PropertyName: "Geofence zone 44", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		351:   {PropertyName: "Geofence zone 45", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		352:   {PropertyName: "Geofence zone 46", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		353:   {PropertyName: "Geofence zone 47", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		354:   {PropertyName: "Geofence zone 48", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		355:   {PropertyName: "Geofence zone 49", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		356:   {PropertyName: "Geofence zone 50", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – target left zone 1 – target entered zone", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		358:   {PropertyName: "Custom Scenario 1", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1},
+		359:   {PropertyName: "Custom Scenario 2", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1},
+		360:   {PropertyName: "Custom Scenario 3", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1},
+		361:   {PropertyName: "Custom Scenario 4", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1},
+		362:   {PropertyName: "Trace Order", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint16", size: 2},
+		380:   {PropertyName: "Manual CAN 10", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		381:   {PropertyName: "Manual CAN 11", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		382:   {PropertyName: "Manual CAN 12", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		383:   {PropertyName: "Manual CAN 13", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		384:   {PropertyName: "Manual CAN 14", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		385:   {PropertyName: "Manual CAN 15", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		386:   {PropertyName: "Manual CAN 16", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		387:   {PropertyName: "Manual CAN 17", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		388:   {PropertyName: "Manual CAN 18", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		389:   {PropertyName: "Manual CAN 19", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		390:   {PropertyName: "External Sensor Temperature 0", Bytes: "2", Type: "Signed", Min: "-32768", Max: "32767", Multiplier: "-", Units: "°C", Description: "Degrees, °C", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "°C"},
+		391:   {PropertyName: "External Sensor Temperature 1", Bytes: "2", Type: "Signed", Min: "-32768", Max: "32767", Multiplier: "-", Units: "°C", Description: "Degrees, °C", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "°C"},
+		392:   {PropertyName: "External Sensor Temperature 2", Bytes: "2", Type: "Signed", Min: "-32768", Max: "32767", Multiplier: "-", Units: "°C", Description: "Degrees, °C", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "°C"},
+		393:   {PropertyName: "External Sensor Temperature 3", Bytes: "2", Type: "Signed", Min: "-32768", Max: "32767", Multiplier: "-", Units: "°C", Description: "Degrees, °C", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "°C"},
+		394:   {PropertyName: "External Sensor Temperature 4", Bytes: "2", Type: "Signed", Min: "-32768", Max: "32767", Multiplier: "-", Units: "°C", Description: "Degrees, °C", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "°C"},
+		395:   {PropertyName: "External Sensor Temperature 5", Bytes: "2", Type: "Signed", Min: "-32768", Max: "32767", Multiplier: "-", Units: "°C", Description: "Degrees, °C", HWSupport: "FMB640", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, units: "°C"},
+		400:   {PropertyName: "Total Tires", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Description: "TPMS Total Tires", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "toUint8", size: 1},
+		401:   {PropertyName: "Total Axels", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "-", Description: "TPMS Total Tires", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "toUint8", size: 1},
+		410:   {PropertyName: "Tire 1", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 1 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		411:   {PropertyName: "Tire 2", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 2 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		412:   {PropertyName: "Tire 3", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 3 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		413:   {PropertyName: "Tire 4", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 4 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		414:   {PropertyName: "Tire 5", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 5 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		415:   {PropertyName: "Tire 6", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 6 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		416:   {PropertyName: "Tire 7", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 7 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		417:   {PropertyName: "Tire 8", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 8 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		418:   {PropertyName: "Tire 9", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 9 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		419:   {PropertyName: "Tire 10", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 10 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		420:   {PropertyName: "Tire 11", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 11 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		421:   {PropertyName: "Tire 12", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 12 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		422:   {PropertyName: "Tire 13", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 13 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		423:   {PropertyName: "Tire 14", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 14 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		424:   {PropertyName: "Tire 15", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 15 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		425:   {PropertyName: "Tire 16", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 16 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		426:   {PropertyName: "Tire 17", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 17 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		427:   {PropertyName: "Tire 18", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 18 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		428:   {PropertyName: "Tire 19", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 19 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		429:   {PropertyName: "Tire 20", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 20 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		430:   {PropertyName: "Tire 21", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 21 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		431:   {PropertyName: "Tire 22", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 22 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		432:   {PropertyName: "Tire 23", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 23 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		433:   {PropertyName: "Tire 24", Bytes: "8", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Description: "Tire 24 Information", HWSupport: "FMB640", ParametrGroup: "TPMS", FinalConversion: "to[]byte", size: 8},
+		10298: {PropertyName: "Manual CAN 20", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10299: {PropertyName: "Manual CAN 21", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10300: {PropertyName: "Manual CAN 22", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10301: {PropertyName: "Manual CAN 23", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10302: {PropertyName: "Manual CAN 24", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10303: {PropertyName: "Manual CAN 25", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10304: {PropertyName: "Manual CAN 26", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10305: {PropertyName: "Manual CAN 27", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10306: {PropertyName: "Manual CAN 28", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10307: {PropertyName: "Manual CAN 29", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10308: {PropertyName: "Manual CAN 30", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10309: {PropertyName: "Manual CAN 31", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10310: {PropertyName: "Manual CAN 32", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10311: {PropertyName: "Manual CAN 33", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10312: {PropertyName: "Manual CAN 34", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10313: {PropertyName: "Manual CAN 35", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10314: {PropertyName: "Manual CAN 36", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10315: {PropertyName: "Manual CAN 37", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10316: {PropertyName: "Manual CAN 38", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10317: {PropertyName: "Manual CAN 39", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10318: {PropertyName: "Manual CAN 40", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10319: {PropertyName: "Manual CAN 41", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10320: {PropertyName: "Manual CAN 42", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10321: {PropertyName: "Manual CAN 43", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10322: {PropertyName: "Manual CAN 44", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10323: {PropertyName: "Manual CAN 45", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10324: {PropertyName: "Manual CAN 46", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10325: {PropertyName: "Manual CAN 47", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10326: {PropertyName: "Manual CAN 48", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10327: {PropertyName: "Manual CAN 49", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10328: {PropertyName: "Manual CAN 50", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10329: {PropertyName: "Manual CAN 51", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10330: {PropertyName: "Manual CAN 52", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10331: {PropertyName: "Manual CAN 53", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10332: {PropertyName: "Manual CAN 54", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10333: {PropertyName: "Manual CAN 55", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10334: {PropertyName: "Manual CAN 56", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10335: {PropertyName: "Manual CAN 57", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10336: {PropertyName: "Manual CAN 58", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10337: {PropertyName: "Manual CAN 59", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10338: {PropertyName: "Manual CAN 60", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10339: {PropertyName: "Manual CAN 61", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10340: {PropertyName: "Manual CAN 62", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10341: {PropertyName: "Manual CAN 63", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10342: {PropertyName: "Manual CAN 64", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10343: {PropertyName: "Manual CAN 65", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10344: {PropertyName: "Manual CAN 66", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10345: {PropertyName: "Manual CAN 67", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10346: {PropertyName: "Manual CAN 68", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10347: {PropertyName: "Manual CAN 69", Bytes: "Variable", Type: "Unsigned", Min: "0", Max: "0xffffffffffffffff", Multiplier: "-", Units: "-", HWSupport: "FMB640", ParametrGroup: "Manual CAN elements", FinalConversion: "to[]byte"},
+		10348: {PropertyName: "Fuel level 2", Bytes: "4", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "%", Description: "This parameter shows fuel level in secondary tank (if fuel type is different then currently used fuel)", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint32", size: 4, units: "%"},
+		10349: {PropertyName: "MIL indicator", Bytes: "1", Type: "Unsigned", Min: "0", Max: "100", Multiplier: "-", Units: "-", Description: "0 - OFF 1 - Condition Red 2 - Condition Yellow 3 - Condition Info 7 - Not Available There are three possible conditions stated: Red, Yellow, Info. The interpretation of the status is manufacturer dependant and might be different. For details please refer to the manufacturer’s document.", HWSupport: "FMB640", ParametrGroup: "FMS elements", FinalConversion: "toUint8", size: 1},
+	},
+	FamilyFM36: {
+		1:   {No: "1", PropertyName: "Digital Input Status 1", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0 / 1", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		2:   {No: "2", PropertyName: "Digital Input Status 2", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0 / 1", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		3:   {No: "3", PropertyName: "Digital Input Status 3", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0 / 1", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		4:   {No: "4", PropertyName: "Digital Input Status 4", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0 / 1", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		9:   {No: "5", PropertyName: "Analog Input 1", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "-", Units: "mV", Description: "Voltage: mV, 0 – 30000 mV", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mV"},
+		10:  {No: "6", PropertyName: "Analog Input 2", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "-", Units: "mV", Description: "Voltage: mV, 0 – 30000 mV", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mV"},
+		21:  {No: "7", PropertyName: "GSM level", Bytes: "1", Type: "Unsigned", Min: "1", Max: "5", Multiplier: "-", Units: "-", Description: "GSM signal level value in scale 1 – 5", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		24:  {No: "8", PropertyName: "Speed", Bytes: "2", Type: "Unsigned", Min: "0", Max: "1000", Multiplier: "-", Units: "km/h", Description: "Value in km/h, 0 – xxx km/h", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "km/h"},
+		66:  {No: "9", PropertyName: "External Power Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "0.001", Units: "V", Description: "Voltage: mV, 0 – 30000 mV", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		67:  {No: "10", PropertyName: "Battery Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "30000", Multiplier: "-", Units: "mV", Description: "Voltage: mV, 0 – 30000 mV", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mV"},
+		68:  {No: "11", PropertyName: "Battery Current", Bytes: "2", Type: "Unsigned", Min: "0", Max: "2400", Multiplier: "-", Units: "mA", Description: "Current: mA, 0 – 2400 mA", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, units: "mA"},
+		69:  {No: "12", PropertyName: "GNSS Status", Bytes: "1", Type: "Unsigned", Min: "0", Max: "10", Multiplier: "-", Units: "-", Description: "States:0 – GPS module is power off.1 – GPS antenna is disconnected.2 – Working, no GPS FIX.3 – Working, GPS FIX acquired.4 – GPS sleep.5 – GPS antenna is short circuited.", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "GPS Module Power Off", "1": "GPS Antenna Disconnected", "2": "Working, No GPS Fix", "3": "Working, GPS Fix Acquired", "4": "GPS Sleep", "5": "GPS Antenna Short Circuited"}, FinalConversion: "toUint8", size: 1},
+		72:  {No: "13", PropertyName: "Dallas Temperature 1", Bytes: "2", Type: "Signed", Min: "-55", Max: "3000", Multiplier: "0.1", Units: "°C", Description: "10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		73:  {No: "14", PropertyName: "Dallas Temperature 2", Bytes: "2", Type: "Signed", Min: "-55", Max: "3000", Multiplier: "0.1", Units: "°C", Description: "10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		74:  {No: "15", PropertyName: "Dallas Temperature 3", Bytes: "2", Type: "Signed", Min: "-55", Max: "3000", Multiplier: "0.1", Units: "°C", Description: "10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		75:  {No: "16", PropertyName: "Dallas Temperature Sensor ID1", Bytes: "8", Type: "Signed", Min: "0", Max: "-10000000000000000000", Multiplier: "-", Units: "-", Description: "Temperature sensor ID", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8, signed: true},
+		76:  {No: "17", PropertyName: "Dallas Temperature Sensor ID2", Bytes: "8", Type: "Signed", Min: "0", Max: "-10000000000000000000", Multiplier: "-", Units: "-", Description: "Temperature sensor ID", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8, signed: true},
+		77:  {No: "18", PropertyName: "Dallas Temperature Sensor ID3", Bytes: "8", Type: "Signed", Min: "0", Max: "-10000000000000000000", Multiplier: "-", Units: "-", Description: "Temperature sensor ID", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8, signed: true},
+		78:  {No: "19", PropertyName: "iButton ID", Bytes: "8", Type: "Signed", Min: "0", Max: "-10000000000000000000", Multiplier: "-", Units: "-", Description: "iButton ID number", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "to[]byte", size: 8, signed: true},
+		79:  {No: "20", PropertyName: "Network type", Bytes: "1", Type: "Unsigned", Min: "0", Max: "9", Multiplier: "-", Units: "-", Description: "0 – 2G;2 – 3G;8 – LTE-M1;9 – NB-IOT.", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "2G", "2": "3G", "8": "LTE-M1", "9": "NB-IOT"}, FinalConversion: "toUint8", size: 1},
+		80:  {No: "21", PropertyName: "Working Mode", Bytes: "1", Type: "Unsigned", Min: "0", Max: "5", Multiplier: "-", Units: "-", Description: "0 – home on stop,1 – home on move,2 – roaming on stop,3 – roaming on move,4 – unknown on stop,5 – unknown on move", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Home On Stop", "1": "Home On Move", "2": "Roaming On Stop", "3": "Roaming On Move", "4": "Unknown On Stop", "5": "Unknown On Move"}, FinalConversion: "toUint8", size: 1},
+		81:  {No: "34", PropertyName: "LVCAN Speed", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "km/h", Description: "Value in km/h", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1, units: "km/h"},
+		82:  {No: "35", PropertyName: "LVCAN Accelerator Pedal Position", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0.1", Units: "%", Description: "Value in %", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		83:  {No: "36", PropertyName: "LVCAN Fuel Consumed", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "Ltr", Description: "Value in liters * 100", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "Ltr"},
+		84:  {No: "37", PropertyName: "LVCAN Fuel Level (liters)", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "Ltr", Description: "Value in liters * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "Ltr"},
+		85:  {No: "38", PropertyName: "LVCAN Engine RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "-", Description: "Value in RPM", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1},
+		87:  {No: "39", PropertyName: "LVCAN Total Mileage", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "m", Description: "Value in meters, m", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "m"},
+		89:  {No: "40", PropertyName: "LVCAN Fuel Level (percentage)", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0.1", Units: "%", Description: "Value in %", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		90:  {No: "94", PropertyName: "LVCAN Door Status", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "-", Description: "0 – all doors closed256 - front left door opened512 - front right door opened1024 - rear left door opened2048 - rear right door opened4096 - engine cover opened8192 - trunk door opened16128 - all doors opened", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", Bits: map[string]string{"8": "Front left door open", "9": "Front right door open", "10": "Rear left door open", "11": "Rear right door open", "12": "Hood open", "13": "Trunk open"}, FinalConversion: "toUint16", size: 2, multiplier: 1},
+		99:  {No: "22", PropertyName: "Continuous odometer", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "m", Description: "Continuous odometer value: m.", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4, units: "m"},
+		100: {No: "41", PropertyName: "LVCAN Program Number", Bytes: "4", Type: "Unsigned", Min: "0", Max: "999", Multiplier: "1", Units: "-", Description: "Value: Min – 0, Max - 999", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1},
+		101: {No: "42", PropertyName: "LVCAN ModuleID", Bytes: "8", Type: "Signed", Min: "0", Max: "max", Multiplier: "1", Units: "-", Description: "Module identification", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "to[]byte", size: 8, signed: true, multiplier: 1},
+		102: {No: "43", PropertyName: "LVCAN Engine Work Time", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "Min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "Min"},
+		103: {No: "44", PropertyName: "LVCAN Engine Work Time (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "Min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "Min"},
+		104: {No: "45", PropertyName: "LVCAN Total Mileage", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "m", Description: "Value in meters, m", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "m"},
+		105: {No: "46", PropertyName: "LVCAN Total Mileage (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "m", Description: "Value in meters, m", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "m"},
+		106: {No: "47", PropertyName: "LVCAN Fuel Consumed", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "Ltr", Description: "Value in Ltr", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "Ltr"},
+		107: {No: "48", PropertyName: "LVCAN Fuel Consumed (counted)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "Ltr", Description: "Value in liters * 100", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "Ltr"},
+		108: {No: "49", PropertyName: "LVCAN Fuel Level (percent)", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0.1", Units: "%", Description: "Value in %", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		109: {No: "50", PropertyName: "LVCAN Fuel Level (liters)", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "Ltr", Description: "Value in Ltr", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "Ltr"},
+		110: {No: "51", PropertyName: "LVCAN Fuel Rate", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "Ltr/h", Description: "Value in (liters * 10) / h", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "Ltr/h"},
+		111: {No: "52", PropertyName: "LVCAN AdBlue Level (percent)", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0.1", Units: "%", Description: "Value in %", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		112: {No: "53", PropertyName: "LVCAN AdBlue Level (liters)", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "Ltr", Description: "Value in liters * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "Ltr"},
+		113: {No: "54", PropertyName: "LVCAN Engine RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "-", Description: "Value in RPM", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1},
+		114: {No: "55", PropertyName: "LVCAN Engine Load", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "%", Description: "Value in %", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1, units: "%"},
+		115: {No: "56", PropertyName: "LVCAN Engine Temperature", Bytes: "2", Type: "Signed", Min: "0", Max: "65535", Multiplier: "0.1", Units: "°C", Description: "Value in °C x 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		116: {No: "57", PropertyName: "LVCAN Accelerator Pedal Position", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0.1", Units: "%", Description: "Value in %", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		117: {No: "58", PropertyName: "LVCAN Vehicle Speed", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "km/h", Description: "Value in km/h", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1, units: "km/h"},
+		118: {No: "59", PropertyName: "LVCAN Axle 1 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "kg", Description: "Value in kg", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "kg"},
+		119: {No: "60", PropertyName: "LVCAN Axle 2 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "kg", Description: "Value in kg", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "kg"},
+		120: {No: "61", PropertyName: "LVCAN Axle 3 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "kg", Description: "Value in kg", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "kg"},
+		121: {No: "62", PropertyName: "LVCAN Axle 4 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "kg", Description: "Value in kg", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "kg"},
+		122: {No: "63", PropertyName: "LVCAN Axle 5 Load", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "kg", Description: "Value in kg", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "kg"},
+		123: {No: "64", PropertyName: "LVCAN Control State Flags", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "-", Description: "see LVCAN IO element values", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", Bits: map[string]string{"0": "STOP", "1": "Oil pressure / level", "2": "Coolant liquid temperature / level", "3": "Handbrake system", "4": "Battery charging", "5": "AIRBAG", "8": "CHECK ENGINE", "9": "Lights failure", "10": "Low tire pressure", "11": "Wear of brake pads", "12": "Warning", "13": "ABS", "14": "Low Fuel", "16": "ESP", "17": "Glow plug indicator", "18": "FAP", "19": "Electronics pressure control", "20": "Parking lights", "21": "Dipped headlights", "22": "Full beam headlights", "30": "Passenger's seat belt", "31": "Driver's seat belt"}, FinalConversion: "toUint32", size: 4, multiplier: 1},
+		124: {No: "65", PropertyName: "LVCAN Agricultural Machinery Flags", Bytes: "8", Type: "Signed", Min: "0", Max: "max", Multiplier: "1", Units: "-", Description: "see LVCAN IO element values", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", Bits: map[string]string{"0": "Mowing", "1": "Grain release from hopper", "2": "First front hydraulic turned on", "3": "Rear Power Take-Off turned on", "8": "Excessive play under the threshing drum", "9": "Grain tank is open", "10": "100% of Grain tank", "11": "70% of Grain tank", "12": "Drain filter in hydraulic system of drive cylinders is plugged", "13": "Pressure filter of drive cylinders hydraulic system is plugged", "14": "Alarm oil level in oil tank", "15": "Pressure filter of brakes hydraulic system is plugged", "16": "Oil filter of engine is plugged", "17": "Fuel filter is plugged", "18": "Air filter is plugged", "19": "Alarm oil temperature in hydraulic system of chasis", "20": "Alarm oil temperature in hydraulic system of drive cylinders", "21": "Alarm oil pressure in engine", "22": "Alarm coolant level", "23": "Overflow chamber of hydraulic unit", "24": "Unloader drive is ON", "25": "No operator", "26": "Straw walker is plugged", "27": "Water in fuel", "28": "Cleaning fan RPM", "29": "Trashing drum RPM", "33": "Low water level in the tank", "34": "First rear hydraulic turned on", "35": "Standalone engine working", "36": "Right joystick moved right", "37": "Right joystick moved left", "38": "Right joystick moved front", "39": "Right joystick moved back", "40": "Brushes turned on", "41": "Water supply turned on", "42": "Vacuum cleaner", "43": "Unloading from the hopper", "44": "High Pressure washer (Karcher)", "45": "Salt (sand) disperser ON", "46": "Low salt (sand) level", "48": "Second front hydraulic turned on", "49": "Third front hydraulic turned on", "50": "Fourth front hydraulic turned on", "51": "Second rear hydraulic turned on", "52": "Third rear hydraulic turned on", "53": "Fourth rear hydraulic turned on", "54": "Front three-point Hitch turned on", "55": "Rear three-point Hitch turned on", "56": "Left joystick moved right", "57": "Left joystick moved left", "58": "Left joystick moved front", "59": "Left joystick moved back", "60": "Front Power Take-Off turned on"}, FinalConversion: "to[]byte", size: 8, signed: true, multiplier: 1},
+		125: {No: "66", PropertyName: "LVCAN Harvesting Time", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "min", Description: "Vakue in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "min"},
+		126: {No: "67", PropertyName: "LVCAN Area of Harvest", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "m2", Description: "Value in m2", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "m2"},
+		127: {No: "68", PropertyName: "LVCAN Mowing Efficiency", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "m2/h", Description: "Value in m2/h", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "m2/h"},
+		128: {No: "69", PropertyName: "LVCAN Grain Mown Volume", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "kg", Description: "Value in kg", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "kg"},
+		129: {No: "70", PropertyName: "LVCAN Grain Moisture", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "-", Description: "Value in %", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+		130: {No: "71", PropertyName: "LVCAN Harvesting Drum RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "-", Description: "-", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1},
+		131: {No: "72", PropertyName: "LVCAN Gap Under Harvesting Drum", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "mm", Description: "Value in mm", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1, units: "mm"},
+		132: {No: "73", PropertyName: "LVCAN Security State Flags", Bytes: "8", Type: "Signed", Min: "0", Max: "max", Multiplier: "1", Units: "-", Description: "see LVCAN IO element values", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", Bits: map[string]string{"0": "CAN1 connected", "1": "CAN1 required", "2": "CAN2 connected", "3": "CAN2 required", "4": "CAN3 connected", "5": "CAN3 required", "6": "CAN4 connected", "7": "CAN4 required", "21": "Operate button pressed", "22": "Immobilizer in service mode", "23": "Immobilizer key sequence", "24": "Key in ignition lock", "25": "Ignition on", "26": "Dynamic ignition on", "27": "Webasto", "29": "Car closed by factory remote control", "30": "Factory alarm actuated", "31": "Factory alarm emulated by module", "32": "Parking activated", "36": "Handbrake actuated", "37": "Footbrake actuated", "38": "Engine working", "39": "Reverse on", "40": "Front left door open", "41": "Front right door open", "42": "Rear left door open", "43": "Rear right door open", "44": "Hood open", "45": "Trunk open", "55": "CAN module sleep mode"}, FinalConversion: "to[]byte", size: 8, signed: true, multiplier: 1},
+		133: {No: "74", PropertyName: "LVCAN Tacho Total Vehicle Distance", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "m", Description: "Value in m", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "m"},
+		134: {No: "75", PropertyName: "LVCAN Trip Distance", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "m", Description: "Value in m", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "m"},
+		135: {No: "76", PropertyName: "LVCAN Tacho Vehicle Speed", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "km/h", Description: "Value in km/h", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "km/h"},
+		136: {No: "77", PropertyName: "LVCAN Tacho Driver Card Presence", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "-", Description: "see LVCAN IO element values", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+		137: {No: "78", PropertyName: "LVCAN Driver1 States", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "-", Description: "see LVCAN IO element values", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+		138: {No: "79", PropertyName: "LVCAN Driver2 States", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "-", Description: "see LVCAN IO element values", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+		139: {No: "80", PropertyName: "LVCAN Driver1 Continuous Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "min"},
+		140: {No: "81", PropertyName: "LVCAN Driver2 Continuous Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "min"},
+		141: {No: "82", PropertyName: "LVCAN Driver1 Cumulative Break Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "min"},
+		142: {No: "83", PropertyName: "LVCAN Driver2 Cumulative Break Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "min"},
+		143: {No: "84", PropertyName: "LVCAN Driver1 Duration Of Selected Activity", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "min"},
+		144: {No: "85", PropertyName: "LVCAN Driver2 Duration Of Selected Activity", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "min"},
+		145: {No: "86", PropertyName: "LVCAN Driver1 Cumulative Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "min"},
+		146: {No: "87", PropertyName: "LVCAN Driver2 Cumulative Driving Time", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "min"},
+		147: {No: "88", PropertyName: "LVCAN Driver1 ID High", Bytes: "8", Type: "Signed", Min: "0", Max: "max", Multiplier: "1", Units: "min", Description: "Driver1 ID High", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "to[]byte", size: 8, signed: true, multiplier: 1, units: "min"},
+		148: {No: "89", PropertyName: "LVCAN Driver1 ID Low", Bytes: "8", Type: "Signed", Min: "0", Max: "max", Multiplier: "1", Units: "min", Description: "Driver1 ID Low", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "to[]byte", size: 8, signed: true, multiplier: 1, units: "min"},
+		149: {No: "90", PropertyName: "LVCAN Driver2 ID High", Bytes: "8", Type: "Signed", Min: "0", Max: "max", Multiplier: "1", Units: "min", Description: "Driver2 ID High", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "to[]byte", size: 8, signed: true, multiplier: 1, units: "min"},
+		150: {No: "91", PropertyName: "LVCAN Driver2 ID Low", Bytes: "8", Type: "Signed", Min: "0", Max: "max", Multiplier: "1", Units: "min", Description: "Driver2 ID Low", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "to[]byte", size: 8, signed: true, multiplier: 1, units: "min"},
+		151: {No: "92", PropertyName: "LVCAN Battery Temperature", Bytes: "2", Type: "Signed", Min: "-255", Max: "65535", Multiplier: "0.1", Units: "°C", Description: "Value in °C x 10. Value is signed.", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		152: {No: "93", PropertyName: "LVCAN Battery Level (percent)", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "0.1", Units: "%", Description: "Value in percentages, %", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 0.1, units: "%"},
+		155: {No: "123", PropertyName: "Geofence zone 01", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "Event:0 – target left zone,1 – target entered zone", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		156: {No: "124", PropertyName: "Geofence zone 02", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "Event:0 – target left zone,1 – target entered zone", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		157: {No: "125", PropertyName: "Geofence zone 03", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "Event:0 – target left zone,1 – target entered zone", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		158: {No: "126", PropertyName: "Geofence zone 04", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "Event:0 – target left zone,1 – target entered zone", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		159: {No: "127", PropertyName: "Geofence zone 05", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "Event:0 – target left zone,1 – target entered zone", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		160: {No: "95", PropertyName: "LVCAN DTC Errors", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "-", Description: "DTC faults count", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+		161: {No: "96", PropertyName: "LVCAN Slope of Arm", Bytes: "2", Type: "Signed", Min: "-65535", Max: "65535", Multiplier: "0.1", Units: "°", Description: "Value in °", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°"},
+		162: {No: "97", PropertyName: "LVCAN Rotation of Arm", Bytes: "2", Type: "Signed", Min: "-65535", Max: "65535", Multiplier: "0.1", Units: "°", Description: "Value in °", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°"},
+		163: {No: "98", PropertyName: "LVCAN Eject of Arm", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "m", Description: "Value in m * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "m"},
+		164: {No: "99", PropertyName: "LVCAN Horizontal Dist Arm Vechicle", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "m", Description: "Value in m * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "m"},
+		165: {No: "100", PropertyName: "LVCAN Height Arm Above Ground", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "m", Description: "Value in m * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "m"},
+		166: {No: "101", PropertyName: "LVC Drill RPM", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "-", Description: "-", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1},
+		167: {No: "102", PropertyName: "LVC Amount Of Spread Salt Square Meter", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.01", Units: "m", Description: "Value in g/m2", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.01, units: "m"},
+		168: {No: "103", PropertyName: "LVC Battery Voltage", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.1", Units: "V", Description: "Value in V * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "V"},
+		169: {No: "104", PropertyName: "LVC Amount Spread Fine Grained Salt", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "t", Description: "Value in tons * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "t"},
+		170: {No: "105", PropertyName: "LVCAN Amount Spread Coarse Grained Salt", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "t", Description: "Value in tons * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "t"},
+		171: {No: "106", PropertyName: "LVCAN Amount Spread DiMix", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "t", Description: "Value in tons * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "t"},
+		172: {No: "107", PropertyName: "LVCAN Amount Spread Coarse Grained Calc", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "m3", Description: "Value in m3 * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "m3"},
+		173: {No: "108", PropertyName: "LVCAN Amount Spread Calcium Chloride", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "m3", Description: "Value in m3 * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "m3"},
+		174: {No: "109", PropertyName: "LVCAN Amount Spread Sodium Chloride", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "m3", Description: "Value in m3 * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "m3"},
+		175: {No: "128", PropertyName: "Auto Geofence", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "Event:0 – target left zone,1 – target entered zone", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+		176: {No: "110", PropertyName: "LVCAN Amount Spread Magnesium Chloride", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "m3", Description: "Value in m3 * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "m3"},
+		177: {No: "129", PropertyName: "Idling", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "1 – idling start,0 – idling stop", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Idling Stop", "1": "Idling Start"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		178: {No: "112", PropertyName: "LVCAN Amount Spread Sand", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "t", Description: "Value in tons * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "t"},
+		179: {No: "23", PropertyName: "Digital Output 1 state", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0 / 1", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		180: {No: "24", PropertyName: "Digital Output 2 state", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "Logic: 0 / 1", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint8", size: 1},
+		181: {No: "25", PropertyName: "PDOP", Bytes: "2", Type: "Unsigned", Min: "0", Max: "500", Multiplier: "0.1", Units: "-", Description: "Probability * 10; 0-500", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1},
+		182: {No: "26", PropertyName: "HDOP", Bytes: "2", Type: "Unsigned", Min: "0", Max: "500", Multiplier: "0.1", Units: "-", Description: "Probability * 10; 0-500", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.1},
+		183: {No: "113", PropertyName: "LVCAN Width Pouring Left", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.01", Units: "m", Description: "Value in m * 100", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.01, units: "m"},
+		184: {No: "114", PropertyName: "LVCAN Width Pouring Right", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "0.01", Units: "m", Description: "Value in m * 100", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 0.01, units: "m"},
+		185: {No: "115", PropertyName: "LVCAN Salt Spreader Work Hours", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "h", Description: "Value in h * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "h"},
+		186: {No: "116", PropertyName: "LVCAN Distance During Salting", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "km", Description: "Value in km * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "km"},
+		187: {No: "117", PropertyName: "LVCAN Load Weight", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "kg", Description: "Value in kg", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "kg"},
+		188: {No: "118", PropertyName: "LVC Retarder Load", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "%", Description: "Valid range: 0 – 125%", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1, units: "%"},
+		189: {No: "119", PropertyName: "LVC Cruise Time", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "min", Description: "Value in min", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "min"},
+		190: {No: "120", PropertyName: "LVC CNG Status", Bytes: "1", Type: "Unsigned", Min: "0", Max: "255", Multiplier: "1", Units: "-", Description: "0 – engine not on CNG1 – engine not on CNG", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+		191: {No: "121", PropertyName: "LVC CNG Used", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "kg", Description: "Value in kg * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "kg"},
+		192: {No: "122", PropertyName: "LVC CNG Level", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "1", Units: "%", Description: "Value in % * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint16", size: 2, multiplier: 1, units: "%"},
+		193: {No: "111", PropertyName: "LVCAN Amount Spread Gravel", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "0.1", Units: "t", Description: "Value in tons * 10", HWSupport: "FM3612, FM36M1", ParametrGroup: "ALLCAN300/LVCAN200 I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "t"},
+		199: {No: "27", PropertyName: "Odometer Value (Virtual Odometer)", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "1", Units: "m", Description: "Distance between two records: m", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4, multiplier: 1, units: "m"},
+		200: {No: "28", PropertyName: "Deep Sleep", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – not deep sleep mode,1 – deep sleep mode", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Not Deep Sleep Mode", "1": "Deep Sleep Mode"}, FinalConversion: "toUint8", size: 1},
+		205: {No: "29", PropertyName: "Cell ID", Bytes: "4", Type: "Unsigned", Min: "0", Max: "4294967295", Multiplier: "-", Units: "-", Description: "GSM base station ID", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		206: {No: "30", PropertyName: "Area Code", Bytes: "2", Type: "Unsigned", Min: "0", Max: "65536", Multiplier: "-", Units: "-", Description: "Location Area code (LAC), it depends on GSM operator. It provides unique number which assigned to a set of base GSM stations. Max value: 65536", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint16", size: 2},
+		239: {No: "31", PropertyName: "Ignition", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – ignition off,1 – ignition on", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Ignition Off", "1": "Ignition On"}, FinalConversion: "toUint8", size: 1},
+		240: {No: "32", PropertyName: "Movement Sensor", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "-", Units: "-", Description: "0 – not moving,1 – moving", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", Values: map[string]string{"0": "Not Moving", "1": "Moving"}, FinalConversion: "toUint8", size: 1},
+		241: {No: "33", PropertyName: "GSM Operator Code", Bytes: "4", Type: "Unsigned", Min: "0", Max: "65535", Multiplier: "-", Units: "-", Description: "Currently used GSM Operator code", HWSupport: "FM3612, FM36M1", ParametrGroup: "Permanent I/O elements", FinalConversion: "toUint32", size: 4},
+		249: {No: "130", PropertyName: "Jamming detection", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "0 – not jammed,1 – jammed", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Not Jammed", "1": "Jammed"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		250: {No: "131", PropertyName: "Trip", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "1 – trip start,0 – trip stop", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"0": "Trip Stop", "1": "Trip Start"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		251: {No: "132", PropertyName: "Immobilizer", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "1 – iButton connected", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"1": "iButton Connected"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		252: {No: "133", PropertyName: "Authorized driving", Bytes: "1", Type: "Unsigned", Min: "0", Max: "1", Multiplier: "1", Units: "-", Description: "1 – authorized iButton connected", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"1": "Authorized iButton Connected"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		253: {No: "134", PropertyName: "Green driving type", Bytes: "1", Type: "Unsigned", Min: "0", Max: "10", Multiplier: "1", Units: "-", Description: "1 – harsh acceleration,2 – harsh braking,3 - harsh cornering", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", Values: map[string]string{"1": "Harsh Acceleration", "2": "Harsh Braking", "3": "Harsh Cornering"}, FinalConversion: "toUint8", size: 1, multiplier: 1},
+		254: {No: "135", PropertyName: "Green driving value", Bytes: "1", Type: "Unsigned", Min: "0", Max: "10000", Multiplier: "1", Units: "-", Description: "Depending on green driving type: if harsh acceleration or braking – g*100 (value 123 -> 1.23g), if harsh cornering – degrees (value in radians)", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+		255: {No: "136", PropertyName: "Over Speeding", Bytes: "1", Type: "Unsigned", Min: "0", Max: "400", Multiplier: "1", Units: "-", Description: "At over speeding start km/h, at over speeding end km/h", HWSupport: "FM3612, FM36M1", ParametrGroup: "Eventual I/O elements", FinalConversion: "toUint8", size: 1, multiplier: 1},
+	},
+	FamilyFM11XY: {
+		1:   {PropertyName: "Digital Input Status 1", Bytes: "1", Type: "Unsigned", Description: "Logic: 0 / 1", ParametrGroup: "M", FinalConversion: "toUint8", size: 1},
+		2:   {PropertyName: "Digital Input Status 2", Bytes: "1", Type: "Unsigned", Description: "Logic: 0 / 1", ParametrGroup: "M", FinalConversion: "toUint8", size: 1},
+		3:   {PropertyName: "Digital Input Status 3", Bytes: "1", Type: "Unsigned", Description: "Logic: 0 / 1", ParametrGroup: "M", FinalConversion: "toUint8", size: 1},
+		9:   {PropertyName: "Analog Input 1", Bytes: "2", Type: "Unsigned", Description: "Voltage: mV, 0 – 30 V", ParametrGroup: "M", FinalConversion: "toUint16", size: 2},
+		16:  {PropertyName: "Total distance", Bytes: "4", Type: "Unsigned", Description: "Total distance: m", ParametrGroup: "M", FinalConversion: "toUint32", size: 4},
+		21:  {PropertyName: "GSM level", Bytes: "1", Type: "Unsigned", Description: "GSM signal level value in scale 1 – 5", ParametrGroup: "M", FinalConversion: "toUint8", size: 1},
+		24:  {PropertyName: "Speed", Bytes: "2", Type: "Unsigned", Description: "Value in km/h, 0 – xxx km/h", ParametrGroup: "M", FinalConversion: "toUint16", size: 2},
+		66:  {PropertyName: "External Power Voltage", Bytes: "2", Type: "Unsigned", Multiplier: "0.001", Units: "V", Description: "Voltage: mV, 0 – 30 V", ParametrGroup: "M", FinalConversion: "toUint16", size: 2, multiplier: 0.001, units: "V"},
+		69:  {PropertyName: "GPS Status", Bytes: "1", Type: "Unsigned", Description: "States: 0 – GPS module is turned off, 2 – working, but no fix, 3 – working with GPS fix, 4 – GPS module is in sleep state, 5 – antenna is short circuit", ParametrGroup: "M", Values: map[string]string{"0": "GPS Module Is Turned Off", "2": "Working, But No Fix", "3": "Working With GPS Fix", "4": "GPS Module Is In Sleep State", "5": "Antenna Is Short Circuit"}, FinalConversion: "toUint8", size: 1},
+		71:  {PropertyName: "Dallas Temperature ID 4", Bytes: "8", Description: "Dallas sensor ID number", ParametrGroup: "M", FinalConversion: "to[]byte", size: 8},
+		72:  {PropertyName: "Dallas Temperature 1", Bytes: "4", Type: "Signed", Multiplier: "0.1", Units: "°C", Description: "10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error", ParametrGroup: "M", FinalConversion: "toInt32", size: 4, signed: true, multiplier: 0.1, units: "°C"},
+		73:  {PropertyName: "Dallas Temperature 2", Bytes: "4", Type: "Signed", Multiplier: "0.1", Units: "°C", Description: "10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error", ParametrGroup: "M", FinalConversion: "toInt32", size: 4, signed: true, multiplier: 0.1, units: "°C"},
+		74:  {PropertyName: "Dallas Temperature 3", Bytes: "4", Type: "Signed", Multiplier: "0.1", Units: "°C", Description: "10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error", ParametrGroup: "M", FinalConversion: "toInt32", size: 4, signed: true, multiplier: 0.1, units: "°C"},
+		75:  {PropertyName: "Dallas Temperature 4", Bytes: "4", Type: "Signed", Multiplier: "0.1", Units: "°C", Description: "10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error", ParametrGroup: "M", FinalConversion: "toInt32", size: 4, signed: true, multiplier: 0.1, units: "°C"},
+		76:  {PropertyName: "Dallas Temperature ID 1", Bytes: "8", Description: "Dallas sensor ID number", ParametrGroup: "M", FinalConversion: "to[]byte", size: 8},
+		77:  {PropertyName: "Dallas Temperature ID 2", Bytes: "8", Description: "Dallas sensor ID number", ParametrGroup: "M", FinalConversion: "to[]byte", size: 8},
+		78:  {PropertyName: "iButton ID", Bytes: "8", Description: "iButton ID number", ParametrGroup: "M", FinalConversion: "to[]byte", size: 8},
+		79:  {PropertyName: "Dallas Temperature ID 3", Bytes: "8", Description: "Dallas sensor ID number", ParametrGroup: "M", FinalConversion: "to[]byte", size: 8},
+		80:  {PropertyName: "Data Mode", Bytes: "1", Type: "Unsigned", Description: "0 – home on stop, 1 – home on move, 2 – roaming on stop, 3 – roaming on move, 4 – unknown on stop, 5 – unknown on move", ParametrGroup: "M", Values: map[string]string{"0": "Home On Stop", "1": "Home On Move", "2": "Roaming On Stop", "3": "Roaming On Move", "4": "Unknown On Stop", "5": "Unknown On Move"}, FinalConversion: "toUint8", size: 1},
+		81:  {PropertyName: "LVCAN Speed", Bytes: "1", Type: "Unsigned", Description: "Value in km/h", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		82:  {PropertyName: "LVCAN Accelerator Pedal Position", Bytes: "1", Type: "Unsigned", Description: "Value in persentages, %", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		83:  {PropertyName: "LVCAN Total Fuel Used", Bytes: "4", Type: "Unsigned", Multiplier: "0.1", Units: "l", Description: "Value in liters multiplied by 10, L*10", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4, multiplier: 0.1, units: "l"},
+		84:  {PropertyName: "LVCAN Fuel Level (liters)", Bytes: "2", Type: "Unsigned", Description: "Value in liters, L", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		85:  {PropertyName: "LVCAN Engine RPM", Bytes: "2", Type: "Unsigned", Description: "Value in rounds per minute, rpm", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		87:  {PropertyName: "LVCAN Vehicle Distance", Bytes: "4", Type: "Unsigned", Description: "Value in meters, m", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		89:  {PropertyName: "LVCAN Fuel Level (percentage)", Bytes: "1", Type: "Unsigned", Description: "Value in percentages, %", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		90:  {PropertyName: "LVCAN Door Status", Bytes: "2", Type: "Unsigned", Description: "Door status value: Min – 0, Max – 16128 Door status is represented as bitmask converted to decimal value. Possible values: 0 – all doors closed, 0x100 (256) – front left door is opened, 0x200 (512) – front right door is opened, 0x400 (1024) – rear left door is opened, 0x800 (2048) – rear right door is opened, 0x1000 (4096) – hood is opened, 0x2000 (8192) – trunk is opened, 0x3F00 (16128) – all doors are opened, or combinations of values", ParametrGroup: "A2", Bits: map[string]string{"8": "Front left door open", "9": "Front right door open", "10": "Rear left door open", "11": "Rear right door open", "12": "Hood open", "13": "Trunk open"}, FinalConversion: "toUint16", size: 2},
+		100: {PropertyName: "LVCAN Program Number", Bytes: "4", Type: "Unsigned", Description: "Value: Min – 0, Max – 999", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		101: {PropertyName: "LVC ModuleID", Bytes: "8", Description: "Module ID", ParametrGroup: "A2", FinalConversion: "to[]byte", size: 8},
+		102: {PropertyName: "LVC Engine Work Time", Bytes: "4", Type: "Unsigned", Description: "Engine work time in minutes", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		103: {PropertyName: "LVC Engine Work Time (counted)", Bytes: "4", Type: "Unsigned", Description: "Total Engine work time in minutes", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		105: {PropertyName: "LVC Total Mileage (counted)", Bytes: "4", Type: "Unsigned", Description: "Total Vehicle Mileage, m", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		107: {PropertyName: "LVC Fuel Consumed (counted)", Bytes: "4", Type: "Unsigned", Description: "Total Fuel Consumed,liters * 10", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		110: {PropertyName: "LVC Fuel Rate", Bytes: "2", Type: "Unsigned", Multiplier: "0.1", Units: "l/h", Description: "Fuel Rata, liters *10", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2, multiplier: 0.1, units: "l/h"},
+		111: {PropertyName: "LVC AdBlue Level (percent)", Bytes: "1", Type: "Unsigned", Description: "AdBlue, %", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		112: {PropertyName: "LVC AdBlue Level (liters)", Bytes: "2", Type: "Signed", Description: "AdBlue level, L", ParametrGroup: "A2", FinalConversion: "toInt16", size: 2, signed: true},
+		114: {PropertyName: "LVC Engine Load", Bytes: "1", Type: "Unsigned", Description: "Engine load, %", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		115: {PropertyName: "LVC Engine Temperature", Bytes: "2", Type: "Signed", Multiplier: "0.1", Units: "°C", Description: "Engine Temperature, 10 * Degrees ( °C ),", ParametrGroup: "A2", FinalConversion: "toInt16", size: 2, signed: true, multiplier: 0.1, units: "°C"},
+		118: {PropertyName: "LVC Axle 1 Load", Bytes: "2", Type: "Unsigned", Description: "Axle 1 load, kg", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		119: {PropertyName: "LVC Axle 2 Load", Bytes: "2", Type: "Unsigned", Description: "Axle 2 load, kg", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		120: {PropertyName: "LVC Axle 3 Load", Bytes: "2", Type: "Unsigned", Description: "Axle 3 load, kg", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		121: {PropertyName: "LVC Axle 4 Load", Bytes: "2", Type: "Unsigned", Description: "Axle 4 load, kg", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		122: {PropertyName: "LVC Axle 5 Load", Bytes: "2", Type: "Unsigned", Description: "Axle 5 load, kg", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		123: {PropertyName: "LVC Control State Flags", Bytes: "4", Description: "Control state flags Byte0 (LSB): 0x01 – STOP 0x02 – Oil pressure / level 0x04 – Coolant liquid temperature / level 0x08 – Handbrake system 0x10 – Battery charging 0x20 – AIRBAG Byte1:0x01 – CHECK ENGINE 0x02 – Lights failure 0x04 – Low tire pressure 0x08 – Wear of brake pads 0x10 – Warning 0x20 – ABS 0x40 – Low Fuel Byte2:0x01 – ESP 0x02 – Glow plug indicator 0x04 – FAP 0x08 – Electronics pressure control 0x10 – Parking lights 0x20 – Dipped headlights 0x40 – Full beam headlights Byte3: 0x40 – Passenger's seat belt 0x80 – Driver's seat belt", ParametrGroup: "A2", Bits: map[string]string{"0": "STOP", "1": "Oil pressure / level", "2": "Coolant liquid temperature / level", "3": "Handbrake system", "4": "Battery charging", "5": "AIRBAG", "8": "CHECK ENGINE", "9": "Lights failure", "10": "Low tire pressure", "11": "Wear of brake pads", "12": "Warning", "13": "ABS", "14": "Low Fuel", "16": "ESP", "17": "Glow plug indicator", "18": "FAP", "19": "Electronics pressure control", "20": "Parking lights", "21": "Dipped headlights", "22": "Full beam headlights", "30": "Passenger's seat belt", "31": "Driver's seat belt"}, FinalConversion: "to[]byte", size: 4},
+		124: {PropertyName: "LVC Agricultural Machinery Flags", Bytes: "8", Description: "Agricultural machinery flags Byte0 (LSB): 0x01 – Mowing 0x02 – Grain release from hopper 0x04 – First front hydraulic turned on 0x08 – Rear Power Take-Off turned on Byte1: 0x01 – Excessive play under the threshing drum 0x02 – Grain tank is open 0x04 – 100% of Grain tank 0x08 – 70% of Grain tank 0x10 – Drain filter in hydraulic system of drive cylinders is plugged 0x20 – Pressure filter of drive cylinders hydraulic system is plugged 0x40 – Alarm oil level in oil tank 0x80 – Pressure filter of brakes hydraulic system is plugged Byte2: 0x01 – Oil filter of engine is plugged 0x02 – Fuel filter is plugged 0x04 – Air filter is plugged 0x08 – Alarm oil temperature in hydraulic system of chasis 0x10 – Alarm oil temperature in hydraulic system of drive cylinders 0x20 – Alarm oil pressure in engine 0x40 – Alarm coolant level 0x80 – Overflow chamber of hydraulic unit Byte3: 0x01 – Unloader drive is ON. Unloading tube pivot is in idle position 0x02 – No operator! 0x04 – Straw walker is plugged 0x08 – Water in fuel 0x10 – Cleaning fan RPM 0x20 – Trashing drum RPM Byte4:0x02 – Low water level in the tank 0x04 – First rear hydraulic turned on 0x08 – Standalone engine working 0x10 – Right joystick moved right 0x20 – Right joystick moved left 0x40 – Right joystick moved front 0x80 – Right joystick moved back Byte5: 0x01 – Brushes turned on 0x02 – Water supply turned on 0x04 – Vacuum cleaner  0x08 – Unloading from the hopper 0x10 – High Pressure washer (Karcher) 0x20 – Salt (sand) disperser ON 0x40 – Low salt (sand) level Byte6: 0x01 – Second front hydraulic turned on 0x02 – Third front hydraulic turned on 0x04 – Fourth front hydraulic turned on 0x08 – Second rear hydraulic turned on 0x10 – Third rear hydraulic turned on 0x20 – Fourth rear hydraulic turned on 0x40 – Front three-point Hitch turned on 0x80 – Rear three-point Hitch turned on Byte7:0x01 – Left joystick moved right 0x02 – Left joystick moved left 0x04 – Left joystick moved front 0x08 – Left joystick moved back 0x10 – Front Power Take-Off turned on", ParametrGroup: "A2", Bits: map[string]string{"0": "Mowing", "1": "Grain release from hopper", "2": "First front hydraulic turned on", "3": "Rear Power Take-Off turned on", "8": "Excessive play under the threshing drum", "9": "Grain tank is open", "10": "100% of Grain tank", "11": "70% of Grain tank", "12": "Drain filter in hydraulic system of drive cylinders is plugged", "13": "Pressure filter of drive cylinders hydraulic system is plugged", "14": "Alarm oil level in oil tank", "15": "Pressure filter of brakes hydraulic system is plugged", "16": "Oil filter of engine is plugged", "17": "Fuel filter is plugged", "18": "Air filter is plugged", "19": "Alarm oil temperature in hydraulic system of chasis", "20": "Alarm oil temperature in hydraulic system of drive cylinders", "21": "Alarm oil pressure in engine", "22": "Alarm coolant level", "23": "Overflow chamber of hydraulic unit", "24": "Unloader drive is ON", "25": "No operator", "26": "Straw walker is plugged", "27": "Water in fuel", "28": "Cleaning fan RPM", "29": "Trashing drum RPM", "33": "Low water level in the tank", "34": "First rear hydraulic turned on", "35": "Standalone engine working", "36": "Right joystick moved right", "37": "Right joystick moved left", "38": "Right joystick moved front", "39": "Right joystick moved back", "40": "Brushes turned on", "41": "Water supply turned on", "42": "Vacuum cleaner", "43": "Unloading from the hopper", "44": "High Pressure washer (Karcher)", "45": "Salt (sand) disperser ON", "46": "Low salt (sand) level", "48": "Second front hydraulic turned on", "49": "Third front hydraulic turned on", "50": "Fourth front hydraulic turned on", "51": "Second rear hydraulic turned on", "52": "Third rear hydraulic turned on", "53": "Fourth rear hydraulic turned on", "54": "Front three-point Hitch turned on", "55": "Rear three-point Hitch turned on", "56": "Left joystick moved right", "57": "Left joystick moved left", "58": "Left joystick moved front", "59": "Left joystick moved back", "60": "Front Power Take-Off turned on"}, FinalConversion: "to[]byte", size: 8},
+		125: {PropertyName: "LVC Harvesting Time", Bytes: "4", Type: "Unsigned", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		126: {PropertyName: "LVC Area of Harvest", Bytes: "4", Type: "Unsigned", Description: "Area of Harvest, m^2", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		127: {PropertyName: "LVC Mowing Efficiency", Bytes: "4", Type: "Unsigned", Description: "Mowing efficiency, (m^2)/h", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		128: {PropertyName: "LVC Grain Mown Volume", Bytes: "4", Type: "Unsigned", Description: "Mown Volume, kg", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		129: {PropertyName: "LVC Grain Moisture", Bytes: "2", Type: "Unsigned", Description: "Grain Moisture in proc, %", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		130: {PropertyName: "LVC Harvesting Drum RPM", Bytes: "2", Type: "Unsigned", Description: "Harvesting Drum RPM, RPM", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		131: {PropertyName: "LVC Gap Under Harvesting Drum", Bytes: "1", Type: "Unsigned", Description: "Gap Under Harvesting Drum, mm", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		132: {PropertyName: "LVC Security State Flags", Bytes: "8", Description: "Security State Flag Byte0 (LSB): Every two bits in this byte correspond to a different CAN bus number. 00 – CAN not connected, connection not required 01 – CAN connected, but currently module not received data 10 – CAN not connected, require connection 11 – CAN connectedExample: Byte0 - 0F hex – 00001111 binary CAN4, CAN3, CAN2, CAN1 Byte1: Not used Byte2: 0x20 – bit appears when any operate button in car was put 0x40 – bit appears when immobilizer is in service mode 0x80 – immobiliser, bit appears during introduction of a programmed sequence of keys in the car. Byte3: 0x01 – the key is in ignition lock 0x02 – ignition on 0x04 – dynamic ignition on 0x08 – webasto 0x20 – car closed by factory's remote control 0x40 – factory-installed alarm system is actuated (is in panic mode) 0x80 – factory-installed alarm system is emulated by module Byte4: 0x01 – parking activated (automatic gearbox) 0x10 – handbrake is actuated (information available only with ignition on) 0x20 – footbrake is actuated (information available only with ignition on) 0x40 – engine is working (information available only when the ignition on) 0x80 – revers is on Byte5: 0x01 – Front left door opened 0x02 – Front right door opened 0x04 – Rear left door opened 0x08 – Rear right door opened 0x10 – engine cover opened 0x20 – trunk door opened Byte6: 0x01 – car was closed by the factory's remote control 0x02 – car was opened by the factory's remote control 0x03 – trunk cover was opened by the factory's remote control 0x04 – module has sent a rearming signal 0x05 – car was closed three times by the factory's remote control - High nibble (mask 0xF0 bit) 0x80 – CAN module goes to sleep mode Byte7: Not used", ParametrGroup: "A2", Bits: map[string]string{"0": "CAN1 connected", "1": "CAN1 required", "2": "CAN2 connected", "3": "CAN2 required", "4": "CAN3 connected", "5": "CAN3 required", "6": "CAN4 connected", "7": "CAN4 required", "21": "Operate button pressed", "22": "Immobilizer in service mode", "23": "Immobilizer key sequence", "24": "Key in ignition lock", "25": "Ignition on", "26": "Dynamic ignition on", "27": "Webasto", "29": "Car closed by factory remote control", "30": "Factory alarm actuated", "31": "Factory alarm emulated by module", "32": "Parking activated", "36": "Handbrake actuated", "37": "Footbrake actuated", "38": "Engine working", "39": "Reverse on", "40": "Front left door open", "41": "Front right door open", "42": "Rear left door open", "43": "Rear right door open", "44": "Hood open", "45": "Trunk open", "55": "CAN module sleep mode"}, FinalConversion: "to[]byte", size: 8},
+		133: {PropertyName: "LVC Tacho Total Vehicle Distance", Bytes: "4", Type: "Unsigned", Description: "Tacho Total Vehicle Distance, m", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		134: {PropertyName: "LVC Trip Distance", Bytes: "4", Type: "Unsigned", Description: "Trip Distance, m", ParametrGroup: "A2", FinalConversion: "toUint32", size: 4},
+		135: {PropertyName: "LVC Tacho Vehicle Speed", Bytes: "2", Type: "Unsigned", Description: "Tacho Vehicle Speed, km/h", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		136: {PropertyName: "LVC Tacho Driver Card Presence", Bytes: "1", Type: "Unsigned", Description: "Tacho Driver Card Presence 0x00 – No driver card 0x01 – Driver1 card presence 0x02 – Driver2 card presence 0x03 – Driver1 and driver2 cards present", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		137: {PropertyName: "LVC Driver1 States", Bytes: "1", Type: "Unsigned", Description: "Driver1 States 0xX0 – break/rest 0xX1 – availability 0xX2 – work 0xX3 – driving 0x0X – no time-related warning detected 0x1X – limit #1: 15 min before 4 1/2 h 0x2X – limit #2: 4 1/2 h reached (continuous driving time exceeded) 0x3X – limit #3: 15 minutes before optional warning 1 0x4X – limit #4: optional warning 1 reached 0x5X – limit #5: 15 min before optional warning 0x6X – limit #6: optional warning 2 reached", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		138: {PropertyName: "LVC Driver2 States", Bytes: "1", Type: "Unsigned", Description: "Driver2 States 0xX0 – break/rest 0xX1 – availability 0xX2 – work 0xX3 – driving 0x0X – no time-related warning detected 0x1X – limit #1: 15 min before 4 1/2 h 0x2X – limit #2: 4 1/2 h reached (continuous driving time exceeded) 0x3X – limit #3: 15 minutes before optional warning 1 0x4X – limit #4: optional warning 1 reached 0x5X – limit #5: 15 min before optional warning 0x6X – limit #6: optional warning 2 reached", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		139: {PropertyName: "LVC Driver1 Continuous Driving Time", Bytes: "2", Type: "Unsigned", Description: "Driver1 Continuous Driving Time, minutes", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		140: {PropertyName: "LVC Driver2 Continuous Driving Time", Bytes: "2", Type: "Unsigned", Description: "Driver2 Continuous Driving Time, minutes", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		141: {PropertyName: "LVC Driver1 Cumulative Break Time", Bytes: "2", Type: "Unsigned", Description: "Driver1 Cumulative Break Time, minutes", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		142: {PropertyName: "LVC Driver2 Cumulative", Bytes: "2", Type: "Unsigned", Description: "Driver2 Cumulative Break Time, minutes", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		143: {PropertyName: "LVC Driver1 Duration Of Selected Activity", Bytes: "2", Type: "Unsigned", Description: "Driver1 Duration Of Selected Activity, minutes", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		144: {PropertyName: "LVC Driver2 Duration Of Selected Activity", Bytes: "2", Type: "Unsigned", Description: "Driver2 Duration Of Selected Activity, minutes", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		145: {PropertyName: "LVC Driver1 Cumulative Driving Time", Bytes: "2", Type: "Unsigned", Description: "Driver1 Cumulative Driving Time, minutes", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		146: {PropertyName: "LVC Driver2 Cumulative Driving Time", Bytes: "2", Type: "Unsigned", Description: "Driver2 Cumulative Driving Time, minutes", ParametrGroup: "A2", FinalConversion: "toUint16", size: 2},
+		147: {PropertyName: "LVC Driver1 ID High", Bytes: "8", Description: "Driver1 ID High", ParametrGroup: "A2", FinalConversion: "to[]byte", size: 8},
+		148: {PropertyName: "LVC Driver1 ID Low", Bytes: "8", Description: "Driver1 ID Low", ParametrGroup: "A2", FinalConversion: "to[]byte", size: 8},
+		149: {PropertyName: "LVC Driver2 ID High", Bytes: "8", Description: "Driver2 ID High", ParametrGroup: "A2", FinalConversion: "to[]byte", size: 8},
+		150: {PropertyName: "LVC Driver2 ID Low", Bytes: "8", Description: "Driver2 ID Low", ParametrGroup: "A2", FinalConversion: "to[]byte", size: 8},
+		151: {PropertyName: "LVC Battery Temperature", Bytes: "2", Type: "Signed", Description: "10* Degrees, ( °C )", ParametrGroup: "A2", FinalConversion: "toInt16", size: 2, signed: true},
+		152: {PropertyName: "LVC Battery Level (percent)", Bytes: "1", Type: "Unsigned", Description: "Value in percentages, %", ParametrGroup: "A2", FinalConversion: "toUint8", size: 1},
+		155: {PropertyName: "Geofence zone 01", Bytes: "1", Type: "Unsigned", Description: "Event: 0 – target left zone, 1 – target entered zone", ParametrGroup: "ME", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		156: {PropertyName: "Geofence zone 02", Bytes: "1", Type: "Unsigned", Description: "Event: 0 – target left zone, 1 – target entered zone", ParametrGroup: "ME", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		157: {PropertyName: "Geofence zone 03", Bytes: "1", Type: "Unsigned", Description: "Event: 0 – target left zone, 1 – target entered zone", ParametrGroup: "ME", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		158: {PropertyName: "Geofence zone 04", Bytes: "1", Type: "Unsigned", Description: "Event: 0 – target left zone, 1 – target entered zone", ParametrGroup: "ME", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		159: {PropertyName: "Geofence zone 05", Bytes: "1", Type: "Unsigned", Description: "Event: 0 – target left zone, 1 – target entered zone", ParametrGroup: "ME", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		160: {PropertyName: "LVC DTC Errors", Bytes: "1", Type: "Unsigned", Description: "DTC faults count", ParametrGroup: "O", FinalConversion: "toUint8", size: 1},
+		161: {PropertyName: "LVC Slope Of Arm", Bytes: "2", Type: "Unsigned", Description: "Value in o", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		162: {PropertyName: "LVC Rotation Of Arm", Bytes: "2", Type: "Unsigned", Description: "Value in o", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		163: {PropertyName: "LVC Eject Of Arm", Bytes: "2", Type: "Unsigned", Description: "Value in m * 10", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		164: {PropertyName: "LVC Horizontal Distance Arm Vechicle", Bytes: "2", Type: "Unsigned", Description: "Value in m * 10", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		165: {PropertyName: "LVC Height Arm Above Ground", Bytes: "2", Type: "Unsigned", Description: "Value in m * 10", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		166: {PropertyName: "LVC Drill RPM", Bytes: "2", Type: "Unsigned", Description: "-", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		167: {PropertyName: "LVC Amount Of Spread Salt Square Meter", Bytes: "2", Type: "Unsigned", Description: "Value in g/m2", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		168: {PropertyName: "LVC Battery Voltage", Bytes: "2", Type: "Unsigned", Description: "Value in V * 10", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		169: {PropertyName: "LVC Amount Spread Fine Grained Salt", Bytes: "4", Type: "Unsigned", Description: "Value in tons * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		170: {PropertyName: "LVC Amount Spread Coarse Grained Salt", Bytes: "4", Type: "Unsigned", Description: "Value in tons * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		171: {PropertyName: "LVC Amount Spread DiMix", Bytes: "4", Type: "Unsigned", Description: "Value in tons * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		172: {PropertyName: "LVC Amount Spread Coarse Grained Calcium", Bytes: "4", Type: "Unsigned", Description: "Value in m3 * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		173: {PropertyName: "LVC Amount Spread Calcium Chloride", Bytes: "4", Type: "Unsigned", Description: "Value in m3 * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		174: {PropertyName: "LVC Amount Spread Sodium Chloride", Bytes: "4", Type: "Unsigned", Description: "Value in m3 * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		175: {PropertyName: "Auto Geofence", Bytes: "1", Type: "Unsigned", Description: "Event: 0 – target left zone, 1 – target entered zone", ParametrGroup: "ME", Values: map[string]string{"0": "Target Left Zone", "1": "Target Entered Zone"}, FinalConversion: "toUint8", size: 1},
+		176: {PropertyName: "LVC Amount Spread Magnesium Chloride", Bytes: "4", Type: "Unsigned", Description: "Value in m3 * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		177: {PropertyName: "LVC Amount Spread Gravel", Bytes: "4", Type: "Unsigned", Description: "Value in tons * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		178: {PropertyName: "LVC Amount Spread Sand", Bytes: "4", Type: "Unsigned", Description: "Value in tons * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		179: {PropertyName: "Digital Output 1 state", Bytes: "1", Type: "Unsigned", Description: "Logic: 0 / 1", ParametrGroup: "M", FinalConversion: "toUint8", size: 1},
+		180: {PropertyName: "Digital Output 2 state", Bytes: "1", Type: "Unsigned", Description: "Logic: 0 / 1", ParametrGroup: "M", FinalConversion: "toUint8", size: 1},
+		181: {PropertyName: "PDOP", Bytes: "2", Type: "Unsigned", Description: "Probability * 10; 0-500", ParametrGroup: "M", FinalConversion: "toUint16", size: 2},
+		182: {PropertyName: "HDOP", Bytes: "2", Type: "Unsigned", Description: "Probability * 10; 0-500", ParametrGroup: "M", FinalConversion: "toUint16", size: 2},
+		183: {PropertyName: "LVC Width Pouring Left", Bytes: "2", Type: "Unsigned", Description: "Value in m * 100", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		184: {PropertyName: "LVC Width Pouring Right", Bytes: "2", Type: "Unsigned", Description: "Value in m * 100", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		185: {PropertyName: "LVC Salt Spreader Work", Bytes: "4", Type: "Unsigned", Description: "Value in h * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		186: {PropertyName: "LVC Distance During Salting", Bytes: "4", Type: "Unsigned", Description: "Value in km * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		187: {PropertyName: "LVC Load Weight", Bytes: "4", Type: "Unsigned", Description: "Value in kg", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		188: {PropertyName: "LVC Retarder Load", Bytes: "1", Type: "Unsigned", Description: "Value in % Valid range: 0 – 125%", ParametrGroup: "O", FinalConversion: "toUint8", size: 1},
+		189: {PropertyName: "LVC Cruise Time", Bytes: "4", Type: "Unsigned", Description: "Value in min", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		190: {PropertyName: "LVC CNG Status", Bytes: "1", Type: "Unsigned", Description: "0 – engine not on CNG 1 – engine on CNG", ParametrGroup: "O", Values: map[string]string{"0": "Engine Not On CNG", "1": "Engine On CNG"}, FinalConversion: "toUint8", size: 1},
+		191: {PropertyName: "LVC CNG Used", Bytes: "4", Type: "Unsigned", Description: "Value in kg * 10", ParametrGroup: "O", FinalConversion: "toUint32", size: 4},
+		192: {PropertyName: "LVC CNG Level", Bytes: "2", Type: "Unsigned", Description: "Value in % * 10", ParametrGroup: "O", FinalConversion: "toUint16", size: 2},
+		193: {PropertyName: "LVC Oil level", Bytes: "1", Type: "Unsigned", Description: "0 – Oil level/pressure warning off 1 – Oil level/pressure warning on", ParametrGroup: "O", Values: map[string]string{"0": "Oil Level/Pressure Warning Off", "1": "Oil Level/Pressure Warning On"}, FinalConversion: "toUint8", size: 1},
+		199: {PropertyName: "Odometer/Trip Distance", Bytes: "4", Type: "Unsigned", Description: "Distance between two records: m", ParametrGroup: "M", FinalConversion: "toUint32", size: 4},
+		200: {PropertyName: "Deep Sleep", Bytes: "1", Type: "Unsigned", Description: "0 – not deep sleep mode, 1 – deep sleep mode", ParametrGroup: "M", Values: map[string]string{"0": "Not Deep Sleep Mode", "1": "Deep Sleep Mode"}, FinalConversion: "toUint8", size: 1},
+		205: {PropertyName: "Cell ID", Bytes: "2", Type: "Unsigned", Description: "GSM base station ID", ParametrGroup: "M", FinalConversion: "toUint16", size: 2},
+		206: {PropertyName: "Area Code", Bytes: "2", Type: "Unsigned", Description: "Location Area code (LAC), it depends on", ParametrGroup: "M", FinalConversion: "toUint16", size: 2},
+		239: {PropertyName: "Ignition", Bytes: "1", Type: "Unsigned", Description: "0 – ignition off, 1 – ignition on", ParametrGroup: "M", Values: map[string]string{"0": "Ignition Off", "1": "Ignition On"}, FinalConversion: "toUint8", size: 1},
+		240: {PropertyName: "Movement Sensor", Bytes: "1", Type: "Unsigned", Description: "0 – not moving, 1 – moving", ParametrGroup: "M", Values: map[string]string{"0": "Not Moving", "1": "Moving"}, FinalConversion: "toUint8", size: 1},
+		241: {PropertyName: "GSM Operator Code", Bytes: "4", Type: "Unsigned", Description: "Currently used GSM Operator code", ParametrGroup: "M", FinalConversion: "toUint32", size: 4},
+		249: {PropertyName: "Jamming", Bytes: "1", Type: "Unsigned", Description: "1 – jamming start, 0 – jamming stop", ParametrGroup: "ME", Values: map[string]string{"0": "Jamming Stop", "1": "Jamming Start"}, FinalConversion: "toUint8", size: 1},
+		250: {PropertyName: "Trip", Bytes: "1", Type: "Unsigned", Description: "1 – trip start, 0 – trip stop", ParametrGroup: "ME", Values: map[string]string{"0": "Trip Stop", "1": "Trip Start"}, FinalConversion: "toUint8", size: 1},
+		251: {PropertyName: "Immobilizer", Bytes: "1", Type: "Unsigned", Description: "1 – iButton connected", ParametrGroup: "ME", Values: map[string]string{"1": "iButton Connected"}, FinalConversion: "toUint8", size: 1},
+		252: {PropertyName: "Authorized driving", Bytes: "1", Type: "Unsigned", Description: "1 – authorized iButton connected", ParametrGroup: "ME", Values: map[string]string{"1": "Authorized iButton Connected"}, FinalConversion: "toUint8", size: 1},
+		253: {PropertyName: "Green driving type", Bytes: "1", Type: "Unsigned", Description: "1 – harsh acceleration, 2 – harsh braking, 3 – harsh cornering", ParametrGroup: "ME", Values: map[string]string{"1": "Harsh Acceleration", "2": "Harsh Braking", "3": "Harsh Cornering"}, FinalConversion: "toUint8", size: 1},
+		254: {PropertyName: "Green driving value", Bytes: "1", Type: "Unsigned", Description: "Depending on green driving type: if harsh acceleration or braking – g*100 (value 123 -> 1.23g), if harsh cornering – degrees (value in radians)", ParametrGroup: "ME", FinalConversion: "toUint8", size: 1},
+		255: {PropertyName: "Over Speeding", Bytes: "1", Type: "Unsigned", Description: "At over speeding start km/h, at over speeding end km/h", ParametrGroup: "ME", FinalConversion: "toUint8", size: 1},
+	},
+}
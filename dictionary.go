@@ -0,0 +1,260 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// conversionBytes maps numeric FinalConversion to the required length of the element and its Type
+var conversionBytes = map[string]struct {
+	bytes string
+	typ   string
+}{
+	"toBool":   {"1", "Unsigned"},
+	"toUint8":  {"1", "Unsigned"},
+	"toUint16": {"2", "Unsigned"},
+	"toUint32": {"4", "Unsigned"},
+	"toUint64": {"8", "Unsigned"},
+	"toInt8":   {"1", "Signed"},
+	"toInt16":  {"2", "Signed"},
+	"toInt32":  {"4", "Signed"},
+	"toInt64":  {"8", "Signed"},
+}
+
+// Validate checks that Bytes, Type and FinalConversion of the decoding key are consistent
+func (k *AvlEncodeKey) Validate() error {
+	if k.Bytes != "Variable" {
+		if n, err := strconv.Atoi(k.Bytes); err != nil || n <= 0 {
+			return fmt.Errorf("Invalid Bytes %q, want a positive number or Variable", k.Bytes)
+		}
+	}
+
+	switch k.Type {
+	case "", "Unsigned", "Signed", "String":
+	default:
+		return fmt.Errorf("Invalid Type %q, want Unsigned, Signed or String", k.Type)
+	}
+
+	switch k.FinalConversion {
+	case "", "to[]byte", "toString":
+		return nil
+	}
+	want, ok := conversionBytes[k.FinalConversion]
+	if !ok {
+		return fmt.Errorf("Invalid FinalConversion %q", k.FinalConversion)
+	}
+	if k.Bytes != want.bytes || k.Type != want.typ {
+		return fmt.Errorf("FinalConversion %v requires %v Bytes %v, got %v Bytes %v", k.FinalConversion, want.bytes, want.typ, k.Bytes, k.Type)
+	}
+	return nil
+}
+
+// parse sets the typed fields of a validated decoding key, a Multiplier which is not a positive number is ignored
+func (k *AvlEncodeKey) parse() {
+	k.size, _ = strconv.Atoi(k.Bytes)
+	k.signed = k.Type == "Signed"
+	k.multiplier = 0
+	if m, err := strconv.ParseFloat(strings.Replace(strings.TrimSpace(k.Multiplier), ",", ".", -1), 64); err == nil && m > 0 && !math.IsInf(m, 0) {
+		k.multiplier = m
+	}
+	k.units = k.Units
+	if k.units == "-" {
+		k.units = ""
+	}
+}
+
+// LoadJSON reads decoding keys in the shape of ./teltonikajson/ from r and adds them to device family,
+// existing keys with the same IO ID are overridden, a new family is created if it does not exist.
+// Keys are validated before any of them is added.
+func (h *HumanDecoder) LoadJSON(device string, r io.Reader) error {
+	keys := make(map[uint16]AvlEncodeKey)
+	if err := json.NewDecoder(r).Decode(&keys); err != nil {
+		return fmt.Errorf("Unable to parse JSON dictionary, %v", err)
+	}
+	return h.addKeys(device, keys)
+}
+
+// LoadCSV reads decoding keys in the layout of Teltonika AVL ID tables from r and adds them to device family the same way as LoadJSON.
+// The first row is a header, columns are matched by their names: Property ID in AVL packet, Property Name, Bytes, Type, Min, Max,
+// Multiplier, Units, Description, HW Support, Parameter Group and optional Final Conversion. If Final Conversion is missing,
+// it is derived from Bytes and Type.
+func (h *HumanDecoder) LoadCSV(device string, r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return fmt.Errorf("Unable to parse CSV dictionary, %v", err)
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("Unable to parse CSV dictionary, missing header")
+	}
+
+	// find columns by header names
+	columns := make(map[string]int)
+	for i, name := range rows[0] {
+		if column := csvColumn(name); column != "" {
+			if _, ok := columns[column]; !ok {
+				columns[column] = i
+			}
+		}
+	}
+	for _, column := range []string{"id", "name", "bytes", "type"} {
+		if _, ok := columns[column]; !ok {
+			return fmt.Errorf("Unable to parse CSV dictionary, missing %v column", column)
+		}
+	}
+
+	keys := make(map[uint16]AvlEncodeKey)
+	for n, row := range rows[1:] {
+		field := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+
+		// skip empty rows
+		if field("id") == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(field("id"), 10, 16)
+		if err != nil {
+			return fmt.Errorf("Unable to parse CSV dictionary, row %v, invalid IO ID %q", n+2, field("id"))
+		}
+
+		key := AvlEncodeKey{
+			No:              field("no"),
+			PropertyName:    field("name"),
+			Bytes:           field("bytes"),
+			Type:            field("type"),
+			Min:             field("min"),
+			Max:             field("max"),
+			Multiplier:      field("multiplier"),
+			Units:           field("units"),
+			Description:     field("description"),
+			HWSupport:       field("hwsupport"),
+			ParametrGroup:   field("group"),
+			FinalConversion: field("conversion"),
+		}
+		if _, ok := columns["conversion"]; !ok {
+			key.FinalConversion = finalConversion(key.Bytes, key.Type)
+		}
+		keys[uint16(id)] = key
+	}
+
+	return h.addKeys(device, keys)
+}
+
+// LoadFile reads decoding keys from a .json or .csv file and adds them to device family
+func (h *HumanDecoder) LoadFile(device string, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return h.LoadJSON(device, f)
+	case ".csv":
+		return h.LoadCSV(device, f)
+	}
+	return fmt.Errorf("Unknown dictionary format %v, want .json or .csv", path)
+}
+
+// addKeys validates decoding keys and merges them into device family
+func (h *HumanDecoder) addKeys(device string, keys map[uint16]AvlEncodeKey) error {
+	for id, key := range keys {
+		if err := key.Validate(); err != nil {
+			return fmt.Errorf("Invalid decoding key %v %v, %v", id, key.PropertyName, err)
+		}
+		key.parse()
+		keys[id] = key
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// copy on write, decoders may still read the old maps
+	current := h.elements
+	if current == nil {
+		current = builtinElements()
+	}
+	elements := make(map[string]map[uint16]AvlEncodeKey, len(current)+1)
+	for name, family := range current {
+		elements[name] = family
+	}
+	family := make(map[uint16]AvlEncodeKey, len(current[device])+len(keys))
+	for id, key := range current[device] {
+		family[id] = key
+	}
+	for id, key := range keys {
+		family[id] = key
+	}
+	elements[device] = family
+	h.elements = elements
+
+	// cached decisions and scores of device family detection are based on the old dictionaries
+	h.history = nil
+	h.version++
+	return nil
+}
+
+// csvColumn maps a header of Teltonika AVL ID table to a column
+func csvColumn(header string) string {
+	h := strings.ToLower(strings.Join(strings.Fields(header), " "))
+	switch {
+	case strings.Contains(h, "conversion"):
+		return "conversion"
+	case strings.Contains(h, "id"):
+		return "id"
+	case h == "no" || h == "no.":
+		return "no"
+	case strings.Contains(h, "name"):
+		return "name"
+	case strings.Contains(h, "bytes"):
+		return "bytes"
+	case strings.Contains(h, "type"):
+		return "type"
+	case strings.Contains(h, "min"):
+		return "min"
+	case strings.Contains(h, "max"):
+		return "max"
+	case strings.Contains(h, "multiplier"):
+		return "multiplier"
+	case strings.Contains(h, "unit"):
+		return "units"
+	case strings.Contains(h, "description"):
+		return "description"
+	case strings.Contains(h, "hw") || strings.Contains(h, "support"):
+		return "hwsupport"
+	case strings.Contains(h, "group"):
+		return "group"
+	}
+	return ""
+}
+
+// finalConversion derives FinalConversion from Bytes and Type
+func finalConversion(bytes string, typ string) string {
+	for conversion, want := range conversionBytes {
+		if conversion != "toBool" && want.bytes == bytes && want.typ == typ {
+			return conversion
+		}
+	}
+	if typ == "String" {
+		return "toString"
+	}
+	return "to[]byte"
+}
@@ -0,0 +1,148 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+)
+
+func ExampleHumanDecoder_LoadCSV() {
+	humanDecoder := HumanDecoder{}
+
+	table := `Property ID in AVL packet,Property Name,Bytes,Type,Min,Max,Multiplier,Units,Description,HW Support,Parameter Group
+10800,EYE Temperature 1,2,Signed,-400,1250,0.1,°C,Temperature of EYE sensor,FMB920,Eventual I/O elements`
+
+	if err := humanDecoder.LoadCSV("FMBXY", strings.NewReader(table)); err != nil {
+		log.Panicf("Unable to load CSV, %v\n", err)
+	}
+
+	el := Element{Length: 2, IOID: 10800, Value: []byte{0x00, 0xE1}}
+	decoded, err := humanDecoder.Human(&el, "FMBXY")
+	if err != nil {
+		log.Panicf("Error when converting human, %v\n", err)
+	}
+	val, _ := decoded.GetScaledValue()
+	fmt.Printf("%v: %v %v, %v\n", decoded.AvlEncodeKey.PropertyName, val, decoded.Units(), decoded.AvlEncodeKey.FinalConversion)
+
+	// Output:
+	// EYE Temperature 1: 22.5 °C, toInt16
+}
+
+func TestLoadJSON(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+
+	dictionary := `{
+		"239": {"PropertyName": "Ignition state", "Bytes": "1", "Type": "Unsigned", "FinalConversion": "toBool"},
+		"10801": {"PropertyName": "Custom counter", "Bytes": "4", "Type": "Unsigned", "FinalConversion": "toUint32"}
+	}`
+	if err := humanDecoder.LoadJSON("FMBXY", strings.NewReader(dictionary)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		device string
+		el     Element
+		name   string
+		want   interface{}
+	}{
+		{"FMBXY", Element{Length: 1, IOID: 239, Value: []byte{0x01}}, "Ignition state", true},
+		{"FMBXY", Element{Length: 4, IOID: 10801, Value: []byte{0x00, 0x00, 0x01, 0x00}}, "Custom counter", uint32(256)},
+		{"FMBXY", Element{Length: 1, IOID: 21, Value: []byte{0x03}}, "GSM Signal", uint8(3)},
+	}
+	for _, tt := range tests {
+		decoded, err := humanDecoder.Human(&tt.el, tt.device)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := decoded.GetFinalValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if decoded.AvlEncodeKey.PropertyName != tt.name || got != tt.want {
+			t.Errorf("IO %v: want %v %v, got %v %v", tt.el.IOID, tt.name, tt.want, decoded.AvlEncodeKey.PropertyName, got)
+		}
+	}
+
+	// a new family is created
+	if err := humanDecoder.LoadJSON("FMC13X", strings.NewReader(dictionary)); err != nil {
+		t.Fatal(err)
+	}
+	el := Element{Length: 1, IOID: 239, Value: []byte{0x01}}
+	if _, err := humanDecoder.Human(&el, "FMC13X"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBuiltinTypedFields(t *testing.T) {
+	// typed fields generated by dictgen match the fields parsed by LoadJSON and LoadCSV
+	for family, keys := range builtinElements() {
+		for id, key := range keys {
+			parsed := key
+			parsed.parse()
+			if parsed.size != key.size || parsed.signed != key.signed || parsed.multiplier != key.multiplier || parsed.units != key.units {
+				t.Errorf("%v %v: want %v %v %v %q, got %v %v %v %q", family, id, parsed.size, parsed.signed, parsed.multiplier, parsed.units,
+					key.size, key.signed, key.multiplier, key.units)
+			}
+		}
+	}
+}
+
+func TestLoadValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"bytes", `{"1": {"Bytes": "two", "Type": "Unsigned", "FinalConversion": "to[]byte"}}`},
+		{"type", `{"1": {"Bytes": "2", "Type": "Float", "FinalConversion": "to[]byte"}}`},
+		{"conversion", `{"1": {"Bytes": "2", "Type": "Unsigned", "FinalConversion": "toFloat"}}`},
+		{"bytes mismatch", `{"1": {"Bytes": "2", "Type": "Unsigned", "FinalConversion": "toUint32"}}`},
+		{"type mismatch", `{"1": {"Bytes": "2", "Type": "Unsigned", "FinalConversion": "toInt16"}}`},
+	}
+
+	for _, tt := range tests {
+		humanDecoder := HumanDecoder{}
+		if err := humanDecoder.LoadJSON("FMBXY", strings.NewReader(tt.json)); err == nil {
+			t.Errorf("%v: want validation error", tt.name)
+		}
+	}
+
+	// invalid key does not override existing one
+	humanDecoder := HumanDecoder{}
+	invalid := `{"21": {"PropertyName": "Broken", "Bytes": "1", "Type": "Unsigned", "FinalConversion": "toUint8"}, "22": {"Bytes": "1", "Type": "Signed", "FinalConversion": "toUint8"}}`
+	if err := humanDecoder.LoadJSON("FMBXY", strings.NewReader(invalid)); err == nil {
+		t.Fatal("want validation error")
+	}
+	el := Element{Length: 1, IOID: 21, Value: []byte{0x03}}
+	decoded, err := humanDecoder.Human(&el, "FMBXY")
+	if err != nil || decoded.AvlEncodeKey.PropertyName != "GSM Signal" {
+		t.Errorf("want GSM Signal, got %v", decoded)
+	}
+}
+
+func TestLoadCSVColumns(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+
+	// missing Type column
+	if err := humanDecoder.LoadCSV("FMBXY", strings.NewReader("Property ID in AVL packet,Property Name,Bytes\n1,Din1,1")); err == nil {
+		t.Error("want error for missing Type column")
+	}
+
+	// explicit Final Conversion column
+	table := "Property ID in AVL packet,Property Name,Bytes,Type,Final Conversion\n10802,Custom flag,1,Unsigned,toBool\n"
+	if err := humanDecoder.LoadCSV("FMBXY", strings.NewReader(table)); err != nil {
+		t.Fatal(err)
+	}
+	el := Element{Length: 1, IOID: 10802, Value: []byte{0x01}}
+	decoded, err := humanDecoder.Human(&el, "FMBXY")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val, _ := decoded.GetFinalValue(); val != true {
+		t.Errorf("want true, got %v", val)
+	}
+}
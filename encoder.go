@@ -0,0 +1,185 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// EncodeTCP takes Decoded and return Codec 8, Codec 8 Extended or Codec 16 TCP frame including CRC,
+// IO elements are grouped by their length, so DecodeTCP(EncodeTCP(d)) returns the same Decoded as DecodeTCP returned before
+func EncodeTCP(d Decoded) ([]byte, error) {
+	data, err := encodeAvlData(&d)
+	if err != nil {
+		return nil, err
+	}
+	return encodeTCPFrame(data), nil
+}
+
+// EncodeUDP takes Decoded and return Codec 8, Codec 8 Extended or Codec 16 UDP packet with Packet ID, AVL packet ID and IMEI of d,
+// IO elements are grouped by their length, so DecodeUDP(EncodeUDP(d)) returns the same Decoded as DecodeUDP returned before
+func EncodeUDP(d Decoded) ([]byte, error) {
+	if len(d.IMEI) != 15 && len(d.IMEI) != 16 {
+		return nil, fmt.Errorf("Invalid IMEI length, want 15 or 16, got %v", len(d.IMEI))
+	}
+
+	data, err := encodeAvlData(&d)
+	if err != nil {
+		return nil, err
+	}
+
+	if 6+len(d.IMEI)+len(data) > 0xFFFF {
+		return nil, fmt.Errorf("UDP packet too long, want maximum 65535 Bytes of length, got %v", 6+len(d.IMEI)+len(data))
+	}
+
+	// Length | Packet ID | Not usable byte | AVL packet ID | IMEI length | IMEI | AVL data
+	packet := make([]byte, 8, 8+len(d.IMEI)+len(data))
+	binary.BigEndian.PutUint16(packet[0:2], uint16(6+len(d.IMEI)+len(data)))
+	binary.BigEndian.PutUint16(packet[2:4], d.PacketID)
+	packet[4] = 0x01
+	packet[5] = d.AvlPacketID
+	binary.BigEndian.PutUint16(packet[6:8], uint16(len(d.IMEI)))
+	packet = append(packet, d.IMEI...)
+	return append(packet, data...), nil
+}
+
+// encodeAvlData creates AVL data array from Codec ID to the second Number of Data
+func encodeAvlData(d *Decoded) ([]byte, error) {
+	if d.CodecID != 0x08 && d.CodecID != 0x8e && d.CodecID != 0x10 {
+		return nil, fmt.Errorf("Invalid Codec ID, want 0x08, 0x8E or 0x10, get %v", d.CodecID)
+	}
+	if len(d.Data) > 255 {
+		return nil, fmt.Errorf("Too many AVL data, want maximum 255, got %v", len(d.Data))
+	}
+
+	noOfData := byte(len(d.Data))
+	bs := []byte{d.CodecID, noOfData}
+
+	for i, avl := range d.Data {
+		bs = appendUint64(bs, avl.UtimeMs)
+		bs = append(bs, avl.Priority)
+		bs = appendUint32(bs, uint32(avl.Lng))
+		bs = appendUint32(bs, uint32(avl.Lat))
+		bs = appendUint16(bs, uint16(avl.Altitude))
+		bs = appendUint16(bs, avl.Angle)
+		bs = append(bs, avl.VisSat)
+		bs = appendUint16(bs, avl.Speed)
+
+		// Codec 8 extended and Codec 16 have 2 bytes long Event id, Codec 16 carries Generation Type after it
+		if d.CodecID == 0x8e || d.CodecID == 0x10 {
+			bs = appendUint16(bs, avl.EventID)
+			if d.CodecID == 0x10 {
+				if avl.GenerationType == GenerationNone || avl.GenerationType > GenerationPeriodical {
+					return nil, fmt.Errorf("AVL data %v, invalid Generation Type %v of codec 16", i, avl.GenerationType)
+				}
+				bs = append(bs, byte(avl.GenerationType-1))
+			}
+		} else {
+			if avl.EventID > 0xFF {
+				return nil, fmt.Errorf("AVL data %v, Event id %v does not fit 1 Byte of codec 8", i, avl.EventID)
+			}
+			bs = append(bs, byte(avl.EventID))
+		}
+
+		var err error
+		bs, err = EncodeElements(bs, avl.Elements, d.CodecID)
+		if err != nil {
+			return nil, fmt.Errorf("AVL data %v, %v", i, err)
+		}
+	}
+
+	return append(bs, noOfData), nil
+}
+
+// EncodeElements appends IO elements encoded by Codec ID [0x08, 0x8E, 0x10] to bs and returns the extended slice,
+// elements are grouped by their length into 1, 2, 4 and 8 Bytes groups, other lengths are allowed only in Codec 8 extended
+func EncodeElements(bs []byte, elements []Element, codecID byte) ([]byte, error) {
+	countLen, idLen := 1, 1
+	if codecID == 0x8e {
+		countLen, idLen = 2, 2
+	} else if codecID == 0x10 {
+		idLen = 2
+	}
+
+	// group elements by their length, keeping their order
+	groups := make([][]Element, 5)
+	for _, el := range elements {
+		if int(el.Length) != len(el.Value) {
+			return nil, fmt.Errorf("IO element %v, Length %v does not match length of Value %v", el.IOID, el.Length, len(el.Value))
+		}
+		if idLen == 1 && el.IOID > 0xFF {
+			return nil, fmt.Errorf("IO element %v, ID does not fit 1 Byte of codec %#x", el.IOID, codecID)
+		}
+
+		switch el.Length {
+		case 1:
+			groups[0] = append(groups[0], el)
+		case 2:
+			groups[1] = append(groups[1], el)
+		case 4:
+			groups[2] = append(groups[2], el)
+		case 8:
+			groups[3] = append(groups[3], el)
+		default:
+			if codecID != 0x8e {
+				return nil, fmt.Errorf("IO element %v, length %v is supported only by codec 8 extended", el.IOID, el.Length)
+			}
+			groups[4] = append(groups[4], el)
+		}
+	}
+
+	// variable length elements exist only in Codec 8 extended
+	if codecID != 0x8e {
+		groups = groups[:4]
+	}
+
+	maxCount := 0xFF
+	if countLen == 2 {
+		maxCount = 0xFFFF
+	}
+	if len(elements) > maxCount {
+		return nil, fmt.Errorf("Too many IO elements, want maximum %v, got %v", maxCount, len(elements))
+	}
+
+	bs = appendCount(bs, len(elements), countLen)
+	for i, group := range groups {
+		bs = appendCount(bs, len(group), countLen)
+
+		for _, el := range group {
+			bs = appendCount(bs, int(el.IOID), idLen)
+			// variable length elements carry their length
+			if i == 4 {
+				bs = appendUint16(bs, el.Length)
+			}
+			bs = append(bs, el.Value...)
+		}
+	}
+
+	return bs, nil
+}
+
+// appendUint16 appends a number as 2 Bytes big endian
+func appendUint16(bs []byte, n uint16) []byte {
+	return append(bs, byte(n>>8), byte(n))
+}
+
+// appendUint32 appends a number as 4 Bytes big endian
+func appendUint32(bs []byte, n uint32) []byte {
+	return append(bs, byte(n>>24), byte(n>>16), byte(n>>8), byte(n))
+}
+
+// appendUint64 appends a number as 8 Bytes big endian
+func appendUint64(bs []byte, n uint64) []byte {
+	return appendUint32(appendUint32(bs, uint32(n>>32)), uint32(n))
+}
+
+// appendCount appends a number as 1 or 2 Bytes big endian
+func appendCount(bs []byte, n int, length int) []byte {
+	if length == 2 {
+		return appendUint16(bs, uint16(n))
+	}
+	return append(bs, byte(n))
+}
@@ -0,0 +1,117 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"bytes"
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+// testFramesTCP are valid TCP frames of all AVL codecs, they are used by tests and as the seed corpus of fuzz tests
+var testFramesTCP = []string{
+	"000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF",
+	"000000000000004A8E010000016B412CEE000100000000000000000000000000000000010005000100010100010011001D00010010015E2C880002000B000000003544C87A000E000000001DD7E06A00000100002994",
+	"000000000000004308020000016B40D57B480100000000000000000000000000000001010101000000000000016B40D5C198010000000000000000000000000000000101010101000000020000252C",
+	"000000000000005F10020000016BDBC7833000000000000000000000000000000000000B05040200010000030002000B00270042563A00000000016BDBC7871800000000000000000000000000000000000B05040200010000030002000B00260042563A00000200005FB3",
+	"00000000000000230F010B0000001B5D35A4A00352093081452251234741524D494E2C50494E472A0D0A010000DEDC",
+}
+
+// testPacketsUDP are valid UDP packets, they are used by tests and as the seed corpus of fuzz tests
+var testPacketsUDP = []string{
+	"01e4cafe0128000f333532303934303839333937343634080400000163c803eb02010a2524c01d4a377d00d3012f130032421b0a4503f00150051503ef01510052005900be00c1000ab50008b60006426fd8cd3d1ece605a5400005500007300005a0000c0000007c70000000df1000059d910002d33c65300000000570000000064000000f7bf000000000000000163c803e6e8010a2530781d4a316f00d40131130031421b0a4503f00150051503ef01510052005900be00c1000ab50008b60005426fcbcd3d1ece605a5400005500007300005a0000c0000007c70000000ef1000059d910002d33b95300000000570000000064000000f7bf000000000000000163c803df18010a2536961d4a2e4f00d50134130033421b0a4503f00150051503ef01510052005900be00c1000ab50008b6000542702bcd3d1ece605a5400005500007300005a0000c0000007c70000001ef1000059d910002d33aa5300000000570000000064000000f7bf000000000000000163c8039ce2010a25d8d41d49f42c00dc0123120058421b0a4503f00150051503ef01510052005900be00c1000ab50009b60005427031cd79d8ce605a5400005500007300005a0000c0000007c700000019f1000059d910002d32505300000000570000000064000000f7bf000000000004",
+	"0086cafe0101000f3335323039333038353639383230368e0100000167efa919800200000000000000000000000000000000fc0013000800ef0000f00000150500c80000450200010000710000fc00000900b5000000b600000042305600cd432a00ce6064001100090012ff22001303d1000f0000000200f1000059d90010000000000000000001",
+	"003ACAFE0107000F3335323039333038353639383230360801000001669BF6FAB80000000000000000000000000000000000020200EF00F000000001",
+}
+
+func TestEncodeTCPRoundTrip(t *testing.T) {
+	for _, frame := range testFramesTCP {
+		bs, _ := hex.DecodeString(frame)
+		decoded, err := DecodeTCP(&bs)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		// codec 15 is sent only by devices, EncodeTCP does not support it
+		if decoded.Codec15 != nil {
+			continue
+		}
+
+		encoded, err := EncodeTCP(decoded)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if !bytes.Equal(encoded, bs) {
+			t.Errorf("encoded frame differs\nwant %X\ngot  %X", bs, encoded)
+		}
+
+		redecoded, err := DecodeTCP(&encoded)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if !reflect.DeepEqual(redecoded, decoded) {
+			t.Errorf("decoded frame differs\nwant %+v\ngot  %+v", decoded, redecoded)
+		}
+	}
+}
+
+func TestEncodeUDPRoundTrip(t *testing.T) {
+	for _, packet := range testPacketsUDP {
+		bs, _ := hex.DecodeString(packet)
+		decoded, err := DecodeUDP(&bs)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+
+		encoded, err := EncodeUDP(decoded)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if !bytes.Equal(encoded, bs) {
+			t.Errorf("encoded packet differs\nwant %x\ngot  %x", bs, encoded)
+		}
+
+		redecoded, err := DecodeUDP(&encoded)
+		if err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+		if !reflect.DeepEqual(redecoded, decoded) {
+			t.Errorf("decoded packet differs\nwant %+v\ngot  %+v", decoded, redecoded)
+		}
+	}
+}
+
+func TestEncodeVariableLength(t *testing.T) {
+	decoded := Decoded{
+		CodecID: 0x8e,
+		Data: []AvlData{{
+			UtimeMs:  1560166592000,
+			Priority: 1,
+			Elements: []Element{
+				{Length: 1, IOID: 239, Value: []byte{1}},
+				{Length: 2, IOID: 66, Value: []byte{0x30, 0x56}},
+				{Length: 3, IOID: 385, Value: []byte{0x01, 0x02, 0x03}},
+			},
+		}},
+	}
+
+	encoded, err := EncodeTCP(decoded)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	redecoded, err := DecodeTCP(&encoded)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !reflect.DeepEqual(redecoded.Data[0].Elements, decoded.Data[0].Elements) {
+		t.Errorf("want elements %+v, got %+v", decoded.Data[0].Elements, redecoded.Data[0].Elements)
+	}
+
+	// codec 8 does not support variable length elements and 2 Bytes IO IDs
+	decoded.CodecID = 0x08
+	if _, err := EncodeTCP(decoded); err == nil {
+		t.Error("want error for variable length element in codec 8")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Errors returned as a cause of DecodeError, they can be checked by errors.Is
+var (
+	// ErrTooShort is returned when a packet is shorter than the minimum size
+	ErrTooShort = errors.New("packet too short")
+	// ErrUnexpectedEnd is returned when a field exceeds the end of a packet
+	ErrUnexpectedEnd = errors.New("unexpected end of packet")
+	// ErrNotTeltonika is returned when a UDP packet does not have Teltonika Packet ID 0xCAFE
+	ErrNotTeltonika = errors.New("probably not Teltonika packet")
+	// ErrPreamble is returned when a TCP frame does not start with four zero bytes
+	ErrPreamble = errors.New("invalid preamble, want 0x00000000")
+	// ErrDataLength is returned when a declared data field length does not match the frame size
+	ErrDataLength = errors.New("data field length does not match frame size")
+	// ErrCRC is returned when CRC-16/IBM calculated over the data field does not match the received one
+	ErrCRC = errors.New("CRC mismatch")
+	// ErrInvalidIMEI is returned when IMEI has invalid length, invalid characters or invalid checksum
+	ErrInvalidIMEI = errors.New("invalid IMEI")
+	// ErrInvalidCodec is returned when Codec ID is not supported by the decoder
+	ErrInvalidCodec = errors.New("invalid Codec ID")
+	// ErrInvalidValue is returned when a value of AVL data is out of its range (priority, coordinates, altitude, angle)
+	ErrInvalidValue = errors.New("value out of range")
+	// ErrCountMismatch is returned when a number of data or IO elements does not match its control count
+	ErrCountMismatch = errors.New("count mismatch")
+	// ErrInvalidCommand is returned when a command frame has invalid type or quantity
+	ErrInvalidCommand = errors.New("invalid command")
+)
+
+// Fields reported by DecodeError
+const (
+	FieldFrame          = "Frame"
+	FieldPreamble       = "Preamble"
+	FieldDataLength     = "Data Field Length"
+	FieldCRC            = "CRC"
+	FieldPacketID       = "Packet ID"
+	FieldIMEILength     = "IMEI Length"
+	FieldIMEI           = "IMEI"
+	FieldCodecID        = "Codec ID"
+	FieldNoOfData       = "Number of Data"
+	FieldTimestamp      = "Timestamp"
+	FieldPriority       = "Priority"
+	FieldLongitude      = "Longitude"
+	FieldLatitude       = "Latitude"
+	FieldAltitude       = "Altitude"
+	FieldAngle          = "Angle"
+	FieldSatellites     = "Satellites"
+	FieldSpeed          = "Speed"
+	FieldEventID        = "Event IO ID"
+	FieldGenerationType = "Generation Type"
+	FieldIOCount        = "IO Count"
+	FieldIOID           = "IO ID"
+	FieldIOLength       = "IO Length"
+	FieldIOValue        = "IO Value"
+	FieldCommandType    = "Command Type"
+	FieldQuantity       = "Command Quantity"
+	FieldCommandSize    = "Command Size"
+)
+
+// DecodeError describes a failure of decoding, the cause is one of the Err errors wrapped with details
+type DecodeError struct {
+	Offset int    // Byte offset in the packet where the failed field starts
+	Field  string // Field being parsed, one of Field constants
+	Record int    // Index of AVL data, -1 if the field is not a part of AVL data
+	Err    error  // Cause of the failure
+}
+
+// Error returns description of the failure
+func (e *DecodeError) Error() string {
+	if e.Record >= 0 {
+		return fmt.Sprintf("decoding %v at offset %v of AVL data %v failed, %v", e.Field, e.Offset, e.Record, e.Err)
+	}
+	return fmt.Sprintf("decoding %v at offset %v failed, %v", e.Field, e.Offset, e.Err)
+}
+
+// Unwrap returns the cause of the failure
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// decodeError creates DecodeError outside of AVL data, the cause is wrapped with formatted details
+func decodeError(offset int, field string, cause error, format string, args ...interface{}) *DecodeError {
+	err := cause
+	if format != "" {
+		err = fmt.Errorf("%w, "+format, append([]interface{}{cause}, args...)...)
+	}
+	return &DecodeError{Offset: offset, Field: field, Record: -1, Err: err}
+}
+
+// unexpectedEnd creates DecodeError for a field of length Bytes which exceeds the end of bs
+func unexpectedEnd(bs *[]byte, offset int, field string, length int) *DecodeError {
+	return decodeError(offset, field, ErrUnexpectedEnd, "want %v Bytes, packet length %v", length, len(*bs))
+}
+
+// inRecord sets index of AVL data to DecodeError which was created outside of AVL data context
+func inRecord(err error, record int) error {
+	var de *DecodeError
+	if errors.As(err, &de) && de.Record < 0 {
+		de.Record = record
+	}
+	return err
+}
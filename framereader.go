@@ -0,0 +1,88 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// DefaultMaxFrameSize is the maximum size of a frame read by FrameReader if no other limit is set
+const DefaultMaxFrameSize = 64 * 1024
+
+// ErrFrameTooLarge is returned by FrameReader when a declared data field length exceeds the maximum frame size
+var ErrFrameTooLarge = errors.New("frame exceeds maximum frame size")
+
+// FrameReader splits a TCP byte stream into frames, it reads the preamble and the data field length
+// and buffers the stream until the whole frame is available, no matter how the frames were fragmented or coalesced
+type FrameReader struct {
+	r            *bufio.Reader
+	maxFrameSize int
+}
+
+// NewFrameReader returns FrameReader reading from r, frames larger than maxFrameSize Bytes are rejected by ErrFrameTooLarge,
+// if maxFrameSize <= 0, DefaultMaxFrameSize is used
+func NewFrameReader(r io.Reader, maxFrameSize int) *FrameReader {
+	if maxFrameSize <= 0 {
+		maxFrameSize = DefaultMaxFrameSize
+	}
+	return &FrameReader{
+		r:            bufio.NewReader(r),
+		maxFrameSize: maxFrameSize,
+	}
+}
+
+// ReadIMEIPacket reads IMEI login packet which is sent by a device as the first message of a TCP session,
+// the packet should be decoded by DecodeIMEI
+func (f *FrameReader) ReadIMEIPacket() ([]byte, error) {
+	packet := make([]byte, imeiPacketLen)
+	if _, err := io.ReadFull(f.r, packet); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+// ReadFrame reads one whole frame including the preamble, the data field length and CRC, the returned slice is not reused by later reads.
+// io.EOF is returned only if the stream ended between frames, io.ErrUnexpectedEOF if it ended inside a frame.
+// The stream can not be read further after ErrPreamble or ErrFrameTooLarge because the frame boundary is lost.
+func (f *FrameReader) ReadFrame() ([]byte, error) {
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(f.r, header); err != nil {
+		return nil, err
+	}
+
+	// check for four zero bytes preamble
+	if binary.BigEndian.Uint32(header[0:4]) != 0 {
+		return nil, decodeError(0, FieldPreamble, ErrPreamble, "got %#x", header[0:4])
+	}
+
+	// frame consists of 8 Bytes of header, the data field and 4 Bytes of CRC
+	dataLen := binary.BigEndian.Uint32(header[4:8])
+	if int64(dataLen)+12 > int64(f.maxFrameSize) {
+		return nil, decodeError(4, FieldDataLength, ErrFrameTooLarge, "declared %v Bytes of data, maximum frame size is %v Bytes", dataLen, f.maxFrameSize)
+	}
+
+	frame := make([]byte, 12+int(dataLen))
+	copy(frame, header)
+	if _, err := io.ReadFull(f.r, frame[8:]); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	return frame, nil
+}
+
+// ReadDecoded reads one frame and decodes it by DecodeTCP
+func (f *FrameReader) ReadDecoded() (Decoded, error) {
+	frame, err := f.ReadFrame()
+	if err != nil {
+		return Decoded{}, err
+	}
+	return DecodeTCP(&frame)
+}
@@ -0,0 +1,61 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFrameReader(t *testing.T) {
+	login, _ := hex.DecodeString("000F333536333037303432343431303133")
+	first, _ := hex.DecodeString("000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF")
+	second, _ := hex.DecodeString("000000000000004308020000016B40D57B480100000000000000000000000000000001010101000000000000016B40D5C198010000000000000000000000000000000101010101000000020000252C")
+
+	// coalesced frames read one byte at a time simulate the worst fragmentation
+	stream := bytes.Join([][]byte{login, first, second}, nil)
+	reader := NewFrameReader(iotest.OneByteReader(bytes.NewReader(stream)), 0)
+
+	packet, err := reader.ReadIMEIPacket()
+	if err != nil || !bytes.Equal(packet, login) {
+		t.Fatalf("want IMEI packet %x, got %x, error %v", login, packet, err)
+	}
+
+	frame, err := reader.ReadFrame()
+	if err != nil || !bytes.Equal(frame, first) {
+		t.Fatalf("want frame %x, got %x, error %v", first, frame, err)
+	}
+
+	decoded, err := reader.ReadDecoded()
+	if err != nil || decoded.NoOfData != 2 {
+		t.Fatalf("unexpected decoded frame %+v, error %v", decoded, err)
+	}
+
+	if _, err := reader.ReadFrame(); err != io.EOF {
+		t.Errorf("want io.EOF at the end of stream, got %v", err)
+	}
+
+	// stream ends inside a frame
+	reader = NewFrameReader(bytes.NewReader(first[:30]), 0)
+	if _, err := reader.ReadFrame(); err != io.ErrUnexpectedEOF {
+		t.Errorf("want io.ErrUnexpectedEOF for truncated frame, got %v", err)
+	}
+
+	// declared length exceeds the limit
+	reader = NewFrameReader(bytes.NewReader(first), len(first)-1)
+	if _, err := reader.ReadFrame(); !errors.Is(err, ErrFrameTooLarge) {
+		t.Errorf("want ErrFrameTooLarge, got %v", err)
+	}
+
+	// stream does not start with a frame
+	reader = NewFrameReader(bytes.NewReader(login), 0)
+	if _, err := reader.ReadFrame(); !errors.Is(err, ErrPreamble) {
+		t.Errorf("want ErrPreamble, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func FuzzDecodeUDP(f *testing.F) {
+	for _, seed := range testPacketsUDP {
+		bs, _ := hex.DecodeString(seed)
+		f.Add(bs)
+	}
+
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		decoded, err := DecodeUDP(&bs)
+		if err == nil && len(decoded.Data) != int(decoded.NoOfData) {
+			t.Errorf("want %v AVL data, got %v", decoded.NoOfData, len(decoded.Data))
+		}
+	})
+}
+
+func FuzzDecodeTCP(f *testing.F) {
+	for _, seed := range testFramesTCP {
+		bs, _ := hex.DecodeString(seed)
+		f.Add(bs)
+	}
+	for _, cmd := range testCommands {
+		bs, _ := EncodeCommand(cmd)
+		f.Add(bs)
+	}
+
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		decoded, err := DecodeTCP(&bs)
+		if err == nil && decoded.Codec15 == nil && len(decoded.Data) != int(decoded.NoOfData) {
+			t.Errorf("want %v AVL data, got %v", decoded.NoOfData, len(decoded.Data))
+		}
+	})
+}
+
+func FuzzDecodeElements(f *testing.F) {
+	// IO elements of the test frames encoded back by their codec
+	for _, seed := range testFramesTCP {
+		bs, _ := hex.DecodeString(seed)
+		decoded, err := DecodeTCP(&bs)
+		if err != nil {
+			f.Fatal(err)
+		}
+		for _, avl := range decoded.Data {
+			elements, err := EncodeElements(nil, avl.Elements, decoded.CodecID)
+			if err != nil {
+				f.Fatal(err)
+			}
+			f.Add(elements, decoded.CodecID)
+		}
+	}
+
+	f.Fuzz(func(t *testing.T, bs []byte, codecID byte) {
+		elements, end, err := DecodeElements(&bs, 0, codecID)
+		if err != nil {
+			return
+		}
+		if end > len(bs) {
+			t.Errorf("end Byte %v exceeds length %v", end, len(bs))
+		}
+		for _, el := range elements {
+			if int(el.Length) != len(el.Value) {
+				t.Errorf("IO element %v, Length %v does not match length of Value %v", el.IOID, el.Length, len(el.Value))
+			}
+		}
+	})
+}
+
+func FuzzDecodeCommand(f *testing.F) {
+	for _, cmd := range testCommands {
+		bs, _ := EncodeCommand(cmd)
+		f.Add(bs)
+	}
+
+	f.Fuzz(func(t *testing.T, bs []byte) {
+		cmd, err := DecodeCommand(&bs)
+		if err != nil {
+			return
+		}
+		if _, err := EncodeCommand(cmd); err != nil {
+			t.Errorf("decoded command %+v can not be encoded, %v", cmd, err)
+		}
+	})
+}
@@ -2,8 +2,4 @@ module github.com/JKWalrave/teltonikaparser
 
 go 1.18
 
-require (
-	github.com/davecgh/go-spew v1.1.1
-	github.com/filipkroca/b2n v0.0.0-20190805132448-22fb58c69d13
-	github.com/filipkroca/teltonikaparser v0.0.0-20220306184017-d387dc15c2c8
-)
+require github.com/filipkroca/b2n v0.0.0-20190805132448-22fb58c69d13
@@ -0,0 +1,56 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"errors"
+
+	"github.com/filipkroca/b2n"
+)
+
+// ErrIMEIPacket is returned by DecodeIMEI when a packet is not a valid IMEI login packet
+var ErrIMEIPacket = errors.New("invalid IMEI login packet")
+
+// imeiPacketLen is a length of IMEI login packet, 2 Bytes of IMEI length followed by 15 Bytes of IMEI
+const imeiPacketLen = 17
+
+// IsIMEIPacket reports whether a slice of bytes looks like IMEI login packet 0x000F followed by 15 ASCII digits,
+// the IMEI checksum is not validated
+func IsIMEIPacket(bs *[]byte) bool {
+	if len(*bs) != imeiPacketLen || (*bs)[0] != 0x00 || (*bs)[1] != 0x0F {
+		return false
+	}
+	for _, b := range (*bs)[2:] {
+		if b < '0' || b > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// DecodeIMEI takes a pointer to a slice of bytes with IMEI login packet sent by a device when TCP session is opened,
+// validates it and return IMEI
+// https://wiki.teltonika.lt/view/Codec#Communication_with_server
+func DecodeIMEI(bs *[]byte) (string, error) {
+	if !IsIMEIPacket(bs) {
+		return "", decodeError(0, FieldIMEI, ErrIMEIPacket, "want 0x000F followed by 15 ASCII digits, got %#x", *bs)
+	}
+
+	// decode and validate IMEI
+	imei, err := b2n.ParseIMEI(bs, 2, 15)
+	if err != nil {
+		return "", decodeError(2, FieldIMEI, ErrIMEIPacket, "%v", err)
+	}
+
+	return imei, nil
+}
+
+// IMEIResponse returns a response to IMEI login packet, 0x01 if the device is accepted and the server waits for data, otherwise 0x00
+func IMEIResponse(accept bool) []byte {
+	if accept {
+		return []byte{0x01}
+	}
+	return []byte{0x00}
+}
@@ -0,0 +1,303 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command dictgen compiles the JSON dictionaries of ./teltonikajson/ into Go tables of package teltonikaparser.
+// Every decoding key is validated, an invalid dictionary fails the generation.
+//
+// Usage:
+//
+//	go run ./internal/dictgen -o dictionaries_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/JKWalrave/teltonikaparser/teltonikajson"
+)
+
+// key mirrors teltonikaparser.AvlEncodeKey, the package is not imported so that a broken generated file can be regenerated
+type key struct {
+	No              string            `json:"No"`
+	PropertyName    string            `json:"PropertyName"`
+	Bytes           string            `json:"Bytes"`
+	Type            string            `json:"Type"`
+	Min             string            `json:"Min"`
+	Max             string            `json:"Max"`
+	Multiplier      string            `json:"Multiplier"`
+	Units           string            `json:"Units"`
+	Description     string            `json:"Description"`
+	HWSupport       string            `json:"HWSupport"`
+	ParametrGroup   string            `json:"Parametr Group"`
+	Values          map[string]string `json:"Values"`
+	Bits            map[string]string `json:"Bits"`
+	FinalConversion string            `json:"FinalConversion"`
+
+	// typed fields set by validate and written to the generated tables
+	size       int
+	signed     bool
+	multiplier float64
+	units      string
+}
+
+// family is one dictionary of ./teltonikajson/
+type family struct {
+	constant string // name of the family constant in package teltonikaparser
+	name     string
+	source   string
+}
+
+var families = []family{
+	{"FamilyFMBXY", "FMBXY", teltonikajson.FMBXY},
+	{"FamilyFM64", "FM64", teltonikajson.FM64},
+	{"FamilyFM36", "FM36", teltonikajson.FM36},
+	{"FamilyFM11XY", "FM11XY", teltonikajson.FM11XY},
+}
+
+// conversions maps numeric FinalConversion to the required length and Type
+var conversions = map[string]struct {
+	bytes int
+	typ   string
+}{
+	"toBool":   {1, "Unsigned"},
+	"toUint8":  {1, "Unsigned"},
+	"toUint16": {2, "Unsigned"},
+	"toUint32": {4, "Unsigned"},
+	"toUint64": {8, "Unsigned"},
+	"toInt8":   {1, "Signed"},
+	"toInt16":  {2, "Signed"},
+	"toInt32":  {4, "Signed"},
+	"toInt64":  {8, "Signed"},
+}
+
+func main() {
+	out := flag.String("o", "dictionaries_gen.go", "output file")
+	flag.Parse()
+
+	src, err := generate(families)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dictgen: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "dictgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// generate return formatted Go source with dictionaries of all families
+func generate(families []family) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by go run ./internal/dictgen; DO NOT EDIT.\n\n")
+	buf.WriteString("package teltonikaparser\n\n")
+	buf.WriteString("// builtinDictionaries holds the dictionaries of ./teltonikajson/, they are shared by all decoders and must not be modified\n")
+	buf.WriteString("var builtinDictionaries = map[string]map[uint16]AvlEncodeKey{\n")
+	for _, f := range families {
+		ids, keys, err := parse(f.source)
+		if err != nil {
+			return nil, fmt.Errorf("dictionary %v, %v", f.name, err)
+		}
+
+		fmt.Fprintf(&buf, "%v: {\n", f.constant)
+		for _, id := range ids {
+			fmt.Fprintf(&buf, "%v: {", id)
+			writeKey(&buf, keys[id])
+			buf.WriteString("},\n")
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
+
+// parse decodes a dictionary, validates every decoding key and return sorted IO IDs with keys,
+// unknown fields and duplicate IO IDs are errors
+func parse(source string) ([]uint16, map[uint16]key, error) {
+	dec := json.NewDecoder(strings.NewReader(source))
+	dec.DisallowUnknownFields()
+
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, nil, fmt.Errorf("want JSON object, %v", err)
+	}
+
+	keys := make(map[uint16]key)
+	var ids []uint16
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		name, _ := tok.(string)
+		id, err := strconv.ParseUint(name, 10, 16)
+		if err != nil || id == 0 {
+			return nil, nil, fmt.Errorf("invalid IO ID %q", name)
+		}
+
+		var k key
+		if err := dec.Decode(&k); err != nil {
+			return nil, nil, fmt.Errorf("IO ID %v, %v", id, err)
+		}
+		if _, ok := keys[uint16(id)]; ok {
+			return nil, nil, fmt.Errorf("duplicate IO ID %v", id)
+		}
+		if err := validate(&k); err != nil {
+			return nil, nil, fmt.Errorf("IO ID %v %v, %v", id, k.PropertyName, err)
+		}
+		keys[uint16(id)] = k
+		ids = append(ids, uint16(id))
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, nil, fmt.Errorf("unexpected data after dictionary")
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, keys, nil
+}
+
+// validate checks that a decoding key is consistent, the rules are stricter than AvlEncodeKey.Validate
+// because built-in dictionaries are fixed at build time
+func validate(k *key) error {
+	if k.PropertyName == "" {
+		return fmt.Errorf("missing PropertyName")
+	}
+
+	switch k.Type {
+	case "", "Unsigned", "Signed", "String":
+	default:
+		return fmt.Errorf("invalid Type %q", k.Type)
+	}
+
+	size := 0
+	if k.Bytes != "Variable" {
+		n, err := strconv.Atoi(k.Bytes)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid Bytes %q, want a positive number or Variable", k.Bytes)
+		}
+		if n > 8 && k.Type != "String" {
+			return fmt.Errorf("%v Bytes %v is longer than 8 Bytes, use Variable", k.Type, n)
+		}
+		size = n
+	}
+	k.size, k.signed = size, k.Type == "Signed"
+
+	switch k.FinalConversion {
+	case "", "to[]byte", "toString":
+	default:
+		want, ok := conversions[k.FinalConversion]
+		if !ok {
+			return fmt.Errorf("invalid FinalConversion %q", k.FinalConversion)
+		}
+		if size != want.bytes || k.Type != want.typ {
+			return fmt.Errorf("FinalConversion %v requires %v Bytes %v, got %v Bytes %v", k.FinalConversion, want.bytes, want.typ, k.Bytes, k.Type)
+		}
+	}
+
+	if k.Multiplier != "" && k.Multiplier != "-" {
+		m, err := strconv.ParseFloat(strings.Replace(k.Multiplier, ",", ".", -1), 64)
+		if err != nil || m <= 0 || math.IsInf(m, 0) {
+			return fmt.Errorf("invalid Multiplier %q", k.Multiplier)
+		}
+		k.multiplier = m
+	}
+
+	// the scaled value is in Units, a multiplier folded into Units like "l/h,*100" belongs to Multiplier
+	if strings.Contains(k.Units, "*") {
+		return fmt.Errorf("invalid Units %q, move the multiplier to Multiplier", k.Units)
+	}
+	if k.Units != "-" {
+		k.units = k.Units
+	}
+
+	for v := range k.Values {
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			return fmt.Errorf("invalid value %q of Values, want a decimal number", v)
+		}
+	}
+	for b := range k.Bits {
+		n, err := strconv.Atoi(b)
+		if err != nil || n < 0 || (size > 0 && n >= size*8) {
+			return fmt.Errorf("invalid bit %q of Bits", b)
+		}
+	}
+	return nil
+}
+
+// writeKey writes fields of a decoding key including the typed ones as a composite literal, empty fields are omitted
+func writeKey(buf *bytes.Buffer, k key) {
+	fields := []struct {
+		name  string
+		value string
+	}{
+		{"No", k.No},
+		{"PropertyName", k.PropertyName},
+		{"Bytes", k.Bytes},
+		{"Type", k.Type},
+		{"Min", k.Min},
+		{"Max", k.Max},
+		{"Multiplier", k.Multiplier},
+		{"Units", k.Units},
+		{"Description", k.Description},
+		{"HWSupport", k.HWSupport},
+		{"ParametrGroup", k.ParametrGroup},
+	}
+	for _, f := range fields {
+		if f.value != "" {
+			fmt.Fprintf(buf, "%v: %q, ", f.name, f.value)
+		}
+	}
+	writeMap(buf, "Values", k.Values)
+	writeMap(buf, "Bits", k.Bits)
+	if k.FinalConversion != "" {
+		fmt.Fprintf(buf, "FinalConversion: %q, ", k.FinalConversion)
+	}
+
+	// typed fields, zero values are omitted
+	if k.size != 0 {
+		fmt.Fprintf(buf, "size: %v, ", k.size)
+	}
+	if k.signed {
+		buf.WriteString("signed: true, ")
+	}
+	if k.multiplier != 0 {
+		fmt.Fprintf(buf, "multiplier: %v, ", strconv.FormatFloat(k.multiplier, 'g', -1, 64))
+	}
+	if k.units != "" {
+		fmt.Fprintf(buf, "units: %q", k.units)
+	}
+}
+
+// writeMap writes a map with numeric keys sorted by number
+func writeMap(buf *bytes.Buffer, name string, m map[string]string) {
+	if len(m) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, _ := strconv.ParseInt(keys[i], 10, 64)
+		b, _ := strconv.ParseInt(keys[j], 10, 64)
+		return a < b
+	})
+
+	fmt.Fprintf(buf, "%v: map[string]string{", name)
+	for _, k := range keys {
+		fmt.Fprintf(buf, "%q: %q, ", k, m[k])
+	}
+	buf.WriteString("}, ")
+}
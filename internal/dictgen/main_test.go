@@ -0,0 +1,79 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedUpToDate(t *testing.T) {
+	want, err := generate(families)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile("../../dictionaries_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("dictionaries_gen.go is out of date, run go generate")
+	}
+}
+
+func TestParse(t *testing.T) {
+	ids, keys, err := parse(`{
+		"66": {"PropertyName": "External Voltage", "Bytes": "2", "Type": "Unsigned", "Multiplier": "0,001", "Units": "V", "FinalConversion": "toUint16"},
+		"1": {"PropertyName": "Digital Input 1", "Bytes": "1", "Type": "Unsigned", "Values": {"0": "Off", "1": "On"}, "FinalConversion": "toUint8"}
+	}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 66 || keys[66].Units != "V" {
+		t.Errorf("want sorted IO IDs 1, 66, got %v", ids)
+	}
+	if k := keys[66]; k.size != 2 || k.signed || k.multiplier != 0.001 || k.units != "V" {
+		t.Errorf("want typed fields 2 false 0.001 V, got %v %v %v %v", k.size, k.signed, k.multiplier, k.units)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		err    string
+	}{
+		{"syntax", `{"1": {"PropertyName": "Din1",}}`, "IO ID 1"},
+		{"not object", `[]`, "want JSON object"},
+		{"IO ID", `{"x": {"PropertyName": "Din1", "Bytes": "1"}}`, "invalid IO ID"},
+		{"duplicate", `{"1": {"PropertyName": "Din1", "Bytes": "1"}, "1": {"PropertyName": "Din1", "Bytes": "1"}}`, "duplicate IO ID 1"},
+		{"unknown field", `{"1": {"PropertyName": "Din1", "Bytes": "1", "Multiplyer": "1"}}`, "unknown field"},
+		{"name", `{"1": {"Bytes": "1"}}`, "missing PropertyName"},
+		{"bytes", `{"1": {"PropertyName": "Din1", "Bytes": "1-8"}}`, "invalid Bytes"},
+		{"long number", `{"1": {"PropertyName": "Din1", "Bytes": "43678", "Type": "Unsigned"}}`, "longer than 8 Bytes"},
+		{"type", `{"1": {"PropertyName": "Din1", "Bytes": "1", "Type": "Float"}}`, "invalid Type"},
+		{"conversion", `{"1": {"PropertyName": "Din1", "Bytes": "1", "Type": "Unsigned", "FinalConversion": "toFloat"}}`, "invalid FinalConversion"},
+		{"mismatch", `{"1": {"PropertyName": "Din1", "Bytes": "1", "Type": "Unsigned", "FinalConversion": "toUint16"}}`, "requires 2 Bytes"},
+		{"multiplier", `{"1": {"PropertyName": "Din1", "Bytes": "1", "Multiplier": "acc and braking: 0.01"}}`, "invalid Multiplier"},
+		{"units", `{"1": {"PropertyName": "Fuel Rate", "Bytes": "2", "Multiplier": "100", "Units": "l/h,*100"}}`, "invalid Units"},
+		{"values", `{"1": {"PropertyName": "Din1", "Bytes": "1", "Values": {"on": "On"}}}`, "invalid value"},
+		{"bits", `{"1": {"PropertyName": "Din1", "Bytes": "1", "Bits": {"8": "Flag"}}}`, "invalid bit"},
+		{"trailing", `{"1": {"PropertyName": "Din1", "Bytes": "1"}} {}`, "unexpected data"},
+	}
+
+	for _, tt := range tests {
+		_, _, err := parse(tt.source)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%v: want error containing %q, got %v", tt.name, tt.err, err)
+		}
+	}
+
+	// invalid dictionary fails the generation
+	if _, err := generate([]family{{"FamilyFMBXY", "FMBXY", `{"1": {"Bytes": "1"}}`}}); err == nil || !strings.Contains(err.Error(), "dictionary FMBXY") {
+		t.Errorf("want generation error, got %v", err)
+	}
+}
@@ -2,40 +2,50 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package teltonikaparser
 
 import (
-	"fmt"
-
 	"github.com/filipkroca/b2n"
 )
 
-// DecodeElements take pointer to a byte slice with raw data, start Byte position and Codec ID, and returns slice of Element
+// DecodeElements take pointer to a byte slice with raw data, start Byte position and Codec ID [0x08, 0x8E, 0x10], and returns slice of Element
 func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error) {
 
+	if codecID != 0x08 && codecID != 0x8e && codecID != 0x10 {
+		return []Element{}, 0, decodeError(start, FieldCodecID, ErrInvalidCodec, "want 0x08, 0x8E or 0x10, got %#x", codecID)
+	}
+	if start < 0 {
+		return []Element{}, 0, decodeError(start, FieldIOCount, ErrUnexpectedEnd, "start Byte position is negative")
+	}
+
 	var totalElements int
 	codecLenDel := 1
+	idLen := 1
 	if codecID == 0x8e {
 		// if Codec 8 extended is used, Event id has size 2 bytes
-		// Codec ID	0x08	0x8E
-		// AVL Data IO element length	1 Byte	2 Bytes
-		// AVL Data IO element total IO count length	1 Byte	2 Bytes
-		// AVL Data IO element IO count length	1 Byte	2 Bytes
-		// AVL Data IO element AVL ID length	1 Byte	2 Bytes
+		// Codec ID	0x08	0x8E	0x10
+		// AVL Data IO element length	1 Byte	2 Bytes	1 Byte
+		// AVL Data IO element total IO count length	1 Byte	2 Bytes	1 Byte
+		// AVL Data IO element IO count length	1 Byte	2 Bytes	1 Byte
+		// AVL Data IO element AVL ID length	1 Byte	2 Bytes	2 Bytes
 		codecLenDel = 2
+		idLen = 2
+	} else if codecID == 0x10 {
+		// if Codec 16 is used, only AVL ID has size 2 bytes
+		idLen = 2
 	}
 	// parse number of elements and prepare array
 	if codecID == 0x8e {
 		x, err := b2n.ParseBs2Uint16(bs, start)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements error %v", err)
+			return []Element{}, 0, unexpectedEnd(bs, start, FieldIOCount, 2)
 		}
 
 		totalElements = int(x)
-	} else if codecID == 0x08 {
+	} else if codecID == 0x08 || codecID == 0x10 {
 		x, err := b2n.ParseBs2Uint8(bs, start)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements error %v", err)
+			return []Element{}, 0, unexpectedEnd(bs, start, FieldIOCount, 1)
 		}
 
 		totalElements = int(x)
@@ -50,14 +60,14 @@ func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error)
 	// parse 1Byte ios
 	x, err := b2n.ParseBs2Uint8(bs, nextByte)
 	if err != nil {
-		return []Element{}, 0, fmt.Errorf("DecodeElements error %v", err)
+		return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, codecLenDel)
 	}
 	noOfElements := int(x)
 
 	if codecID == 0x8e {
 		z, err := b2n.ParseBs2Uint16(bs, nextByte)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements error %v", err)
+			return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, 2)
 		}
 		noOfElements = int(z)
 	}
@@ -65,27 +75,27 @@ func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error)
 	nextByte = nextByte + codecLenDel
 
 	for ioB := 0; ioB < noOfElements; ioB++ {
-		cutted, err := cutIO(bs, nextByte, codecLenDel, 1)
+		cutted, err := cutIO(bs, nextByte, idLen, 1)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements 1B error %v", err)
+			return []Element{}, 0, err
 		}
 		// append element to the returned slice
 		ElementsBS = append(ElementsBS, cutted)
-		nextByte += codecLenDel + 1
+		nextByte += idLen + 1
 		totalElementsChecksum++
 	}
 
 	// parse 2Byte ios
 	noOfElementsX, err := b2n.ParseBs2Uint8(bs, nextByte)
 	if err != nil {
-		return []Element{}, 0, fmt.Errorf("DecodeElements noOfElements 2B error %v", err)
+		return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, codecLenDel)
 	}
 	noOfElements = int(noOfElementsX)
 
 	if codecID == 0x8e {
 		noOfElementsX, err := b2n.ParseBs2Uint16(bs, nextByte)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements noOfElements 2B Extended Codec error %v", err)
+			return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, 2)
 		}
 		noOfElements = int(noOfElementsX)
 	}
@@ -93,27 +103,27 @@ func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error)
 	nextByte = nextByte + codecLenDel
 
 	for ioB := 0; ioB < noOfElements; ioB++ {
-		cutted, err := cutIO(bs, nextByte, codecLenDel, 2)
+		cutted, err := cutIO(bs, nextByte, idLen, 2)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements 2B error %v", err)
+			return []Element{}, 0, err
 		}
 		// append element to the returned slice
 		ElementsBS = append(ElementsBS, cutted)
-		nextByte += codecLenDel + 2
+		nextByte += idLen + 2
 		totalElementsChecksum++
 	}
 
 	// parse 4Byte ios
 	noOfElementsX, err = b2n.ParseBs2Uint8(bs, nextByte)
 	if err != nil {
-		return []Element{}, 0, fmt.Errorf("DecodeElements noOfElements 4B error %v", err)
+		return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, codecLenDel)
 	}
 	noOfElements = int(noOfElementsX)
 
 	if codecID == 0x8e {
 		noOfElementsX, err := b2n.ParseBs2Uint16(bs, nextByte)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements noOfElements 4B Extended Codec error %v", err)
+			return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, 2)
 		}
 		noOfElements = int(noOfElementsX)
 	}
@@ -121,27 +131,27 @@ func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error)
 	nextByte = nextByte + codecLenDel
 
 	for ioB := 0; ioB < noOfElements; ioB++ {
-		cutted, err := cutIO(bs, nextByte, codecLenDel, 4)
+		cutted, err := cutIO(bs, nextByte, idLen, 4)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements 4B error %v", err)
+			return []Element{}, 0, err
 		}
 		// append element to the returned slice
 		ElementsBS = append(ElementsBS, cutted)
-		nextByte += codecLenDel + 4
+		nextByte += idLen + 4
 		totalElementsChecksum++
 	}
 
 	// parse 8Byte ios
 	noOfElementsX, err = b2n.ParseBs2Uint8(bs, nextByte)
 	if err != nil {
-		return []Element{}, 0, fmt.Errorf("DecodeElements noOfElements 8B error %v", err)
+		return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, codecLenDel)
 	}
 	noOfElements = int(noOfElementsX)
 
 	if codecID == 0x8e {
 		noOfElementsX, err := b2n.ParseBs2Uint16(bs, nextByte)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements noOfElements 8B Extended Codec error %v", err)
+			return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, 2)
 		}
 		noOfElements = int(noOfElementsX)
 	}
@@ -149,13 +159,13 @@ func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error)
 	nextByte = nextByte + codecLenDel
 
 	for ioB := 0; ioB < noOfElements; ioB++ {
-		cutted, err := cutIO(bs, nextByte, codecLenDel, 8)
+		cutted, err := cutIO(bs, nextByte, idLen, 8)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements 8B error %v", err)
+			return []Element{}, 0, err
 		}
 		// append element to the returned slice
 		ElementsBS = append(ElementsBS, cutted)
-		nextByte += codecLenDel + 8
+		nextByte += idLen + 8
 		totalElementsChecksum++
 	}
 
@@ -164,7 +174,7 @@ func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error)
 
 		noOfElementsX, err := b2n.ParseBs2Uint16(bs, nextByte)
 		if err != nil {
-			return []Element{}, 0, fmt.Errorf("DecodeElements noOfElements variableB Extended Codec error %v", err)
+			return []Element{}, 0, unexpectedEnd(bs, nextByte, FieldIOCount, 2)
 		}
 		noOfElements = int(noOfElementsX)
 
@@ -173,7 +183,7 @@ func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error)
 		for ioB := 0; ioB < noOfElements; ioB++ {
 			cutted, err := cutIOxLen(bs, nextByte)
 			if err != nil {
-				return []Element{}, 0, fmt.Errorf("DecodeElements 2B error %v", err)
+				return []Element{}, 0, err
 			}
 			// append element to the returned slice
 			ElementsBS = append(ElementsBS, cutted)
@@ -184,8 +194,7 @@ func DecodeElements(bs *[]byte, start int, codecID byte) ([]Element, int, error)
 	}
 
 	if totalElementsChecksum != totalElements {
-		// log.Fatalf("Error when counting parsed IO Elements, want %v, got %v", totalElements, totalElementsChecksum)
-		return []Element{}, 0, fmt.Errorf("Error when counting parsed IO Elements, want %v, got %v", totalElements, totalElementsChecksum)
+		return []Element{}, 0, decodeError(start, FieldIOCount, ErrCountMismatch, "counting parsed IO Elements, want %v, got %v", totalElements, totalElementsChecksum)
 	}
 
 	return ElementsBS, nextByte, nil
@@ -208,11 +217,11 @@ func cutIO(bs *[]byte, start int, idLen int, length int) (Element, error) {
 		curIO.IOID, err = b2n.ParseBs2Uint16(bs, start)
 	}
 	if err != nil {
-		return Element{}, fmt.Errorf("cutIO error ParseBs2Uint8 or ParseBs2Uint16, %v", err)
+		return Element{}, unexpectedEnd(bs, start, FieldIOID, idLen)
 	}
 
 	if (start + idLen + length) > len(*bs) {
-		return Element{}, fmt.Errorf("cutIO error, want minimum length of bs %v, got %v, packet %x", start+idLen+length, len(*bs), *bs)
+		return Element{}, unexpectedEnd(bs, start+idLen, FieldIOValue, length)
 	}
 
 	curIO.Value = (*bs)[start+idLen : start+idLen+length]
@@ -228,13 +237,17 @@ func cutIOxLen(bs *[]byte, start int) (Element, error) {
 	// parse element ID according to the length of ID [1, 2] Byte
 	curIO.IOID, err = b2n.ParseBs2Uint16(bs, start)
 	if err != nil {
-		return Element{}, fmt.Errorf("cutIOxLen error, %v", err)
+		return Element{}, unexpectedEnd(bs, start, FieldIOID, 2)
 	}
 
 	// determine length of this variable element
 	curIO.Length, err = b2n.ParseBs2Uint16(bs, start+2)
 	if err != nil {
-		return Element{}, fmt.Errorf("cutIOxLen error, %v", err)
+		return Element{}, unexpectedEnd(bs, start+2, FieldIOLength, 2)
+	}
+
+	if (start + 4 + int(curIO.Length)) > len(*bs) {
+		return Element{}, unexpectedEnd(bs, start+4, FieldIOValue, int(curIO.Length))
 	}
 
 	curIO.Value = (*bs)[start+4 : start+4+int(curIO.Length)]
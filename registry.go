@@ -0,0 +1,251 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DeviceProfile describes a device model and its family used for decoding IO elements
+type DeviceProfile struct {
+	Model  string `json:"Model"`  // Device model, for example FMB920
+	Family string `json:"Family"` // Device family like FamilyFMBXY or a family loaded by LoadJSON, it can be omitted for models in the catalogue
+}
+
+// Registry maps IMEI or IMEI prefix (for example 8 digits TAC) to DeviceProfile, it is safe for concurrent use
+type Registry struct {
+	mu       sync.RWMutex
+	profiles map[string]DeviceProfile
+}
+
+// NewRegistry return an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{profiles: make(map[string]DeviceProfile)}
+}
+
+// Set adds or replaces a profile of IMEI or IMEI prefix
+func (r *Registry) Set(imei string, profile DeviceProfile) error {
+	profile, err := checkProfile(imei, profile)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.profiles == nil {
+		r.profiles = make(map[string]DeviceProfile)
+	}
+	r.profiles[imei] = profile
+	return nil
+}
+
+// Delete removes a profile of IMEI or IMEI prefix
+func (r *Registry) Delete(imei string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.profiles, imei)
+}
+
+// Lookup return a profile of imei, an exact IMEI is preferred, then the longest matching prefix
+func (r *Registry) Lookup(imei string) (DeviceProfile, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for i := len(imei); i > 0; i-- {
+		if profile, ok := r.profiles[imei[:i]]; ok {
+			return profile, true
+		}
+	}
+	return DeviceProfile{}, false
+}
+
+// Len return a number of profiles in the registry
+func (r *Registry) Len() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.profiles)
+}
+
+// LoadJSON reads profiles from r in the shape {"<IMEI or prefix>": {"Model": "FMB920", "Family": "FMBXY"}} and adds them to the registry,
+// profiles are validated before any of them is added
+func (r *Registry) LoadJSON(rd io.Reader) error {
+	profiles := make(map[string]DeviceProfile)
+	if err := json.NewDecoder(rd).Decode(&profiles); err != nil {
+		return fmt.Errorf("Unable to parse JSON registry, %v", err)
+	}
+	return r.addProfiles(profiles)
+}
+
+// LoadCSV reads profiles from r with a header row and columns IMEI, Model and optional Family and adds them to the registry
+func (r *Registry) LoadCSV(rd io.Reader) error {
+	reader := csv.NewReader(rd)
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return fmt.Errorf("Unable to parse CSV registry, %v", err)
+	}
+	if len(rows) == 0 {
+		return fmt.Errorf("Unable to parse CSV registry, missing header")
+	}
+
+	// find columns by header names
+	columns := make(map[string]int)
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, column := range []string{"imei", "model"} {
+		if _, ok := columns[column]; !ok {
+			return fmt.Errorf("Unable to parse CSV registry, missing %v column", column)
+		}
+	}
+
+	profiles := make(map[string]DeviceProfile)
+	for _, row := range rows[1:] {
+		field := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		// skip empty rows
+		if field("imei") == "" {
+			continue
+		}
+		profiles[field("imei")] = DeviceProfile{Model: field("model"), Family: field("family")}
+	}
+	return r.addProfiles(profiles)
+}
+
+// LoadFile reads profiles from a .json or .csv file and adds them to the registry
+func (r *Registry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return r.LoadJSON(f)
+	case ".csv":
+		return r.LoadCSV(f)
+	}
+	return fmt.Errorf("Unknown registry format %v, want .json or .csv", path)
+}
+
+// addProfiles validates profiles and adds them to the registry at once
+func (r *Registry) addProfiles(profiles map[string]DeviceProfile) error {
+	for imei, profile := range profiles {
+		profile, err := checkProfile(imei, profile)
+		if err != nil {
+			return err
+		}
+		profiles[imei] = profile
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.profiles == nil {
+		r.profiles = make(map[string]DeviceProfile, len(profiles))
+	}
+	for imei, profile := range profiles {
+		r.profiles[imei] = profile
+	}
+	return nil
+}
+
+// checkProfile validates IMEI and profile, a missing family is resolved from the catalogue by the model
+func checkProfile(imei string, profile DeviceProfile) (DeviceProfile, error) {
+	if err := checkIMEIPrefix(imei); err != nil {
+		return profile, err
+	}
+	if profile.Family == "" {
+		family, ok := ModelFamily(profile.Model)
+		if !ok {
+			return profile, fmt.Errorf("Missing family of %v, model %q is not in the catalogue", imei, profile.Model)
+		}
+		profile.Family = family
+	}
+	return profile, nil
+}
+
+// checkIMEIPrefix checks that imei is IMEI or its prefix, 1 to 16 digits as accepted by DecodeUDP and DecodeIMEI
+func checkIMEIPrefix(imei string) error {
+	if len(imei) == 0 || len(imei) > 16 {
+		return fmt.Errorf("Invalid IMEI or prefix %q, want 1 to 16 digits", imei)
+	}
+	for _, c := range imei {
+		if c < '0' || c > '9' {
+			return fmt.Errorf("Invalid IMEI or prefix %q, want 1 to 16 digits", imei)
+		}
+	}
+	return nil
+}
+
+// HDecoded represent human readable Decoded
+type HDecoded struct {
+	Decoded  *Decoded      // Decoded packet
+	Profile  DeviceProfile // Profile from Registry, or only Family if it was detected
+	Detected bool          // Family was detected because IMEI is not in Registry
+	Data     [][]HAvlData  // IO elements of every AvlData paired with decoding keys, unknown elements and elements not supported by the model are skipped
+}
+
+// DecodedToHuman takes a pointer to Decoded and return IO elements of all AvlData in human readable format,
+// the device family is looked up in registry by Decoded.IMEI, if registry is nil or IMEI is unknown, the family is detected
+// by DetectIMEIFamily. TCP frames do not carry IMEI, without IMEI the family is detected by DetectFamily from this packet only
+func (h *HumanDecoder) DecodedToHuman(d *Decoded, registry *Registry) (*HDecoded, error) {
+	dictionaries := h.dictionaries()
+
+	hd := HDecoded{Decoded: d}
+	profile, ok := DeviceProfile{}, false
+	if registry != nil {
+		profile, ok = registry.Lookup(d.IMEI)
+	}
+	if ok {
+		if _, known := dictionaries[profile.Family]; !known {
+			return nil, fmt.Errorf("Unknown device family %v of %v", profile.Family, d.IMEI)
+		}
+		hd.Profile = profile
+	} else if len(d.Data) > 0 {
+		var detected Detection
+		var err error
+		if d.IMEI == "" {
+			detected, err = h.DetectFamily(&d.Data)
+		} else {
+			detected, err = h.DetectIMEIFamily(d.IMEI, &d.Data)
+		}
+		if err != nil {
+			return nil, err
+		}
+		hd.Profile = DeviceProfile{Family: detected.Family}
+		hd.Detected = true
+	}
+
+	// HWSupport is checked only for models from the catalogue
+	model, _ := LookupModel(hd.Profile.Model)
+	if model.Family != hd.Profile.Family {
+		model = Model{}
+	}
+
+	hd.Data = make([][]HAvlData, len(d.Data))
+	for i := range d.Data {
+		hd.Data[i] = make([]HAvlData, 0, len(d.Data[i].Elements))
+		for j := range d.Data[i].Elements {
+			decoded, err := human(dictionaries, &d.Data[i].Elements[j], hd.Profile.Family, model)
+			if err != nil {
+				continue
+			}
+			hd.Data[i] = append(hd.Data[i], *decoded)
+		}
+	}
+	return &hd, nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/hex"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func ExampleHumanDecoder_DecodedToHuman() {
+	registry := NewRegistry()
+	err := registry.LoadCSV(strings.NewReader("IMEI,Model,Family\n352093085698206,FMB920,FMBXY\n35209408,FM1100,FM11XY\n"))
+	if err != nil {
+		log.Panicf("Unable to load registry, %v\n", err)
+	}
+
+	bs, _ := hex.DecodeString(detectFMBPacket)
+	parsedData, err := DecodeUDP(&bs)
+	if err != nil {
+		log.Panicf("Error when decoding a bs, %v\n", err)
+	}
+
+	humanDecoder := HumanDecoder{}
+	decoded, err := humanDecoder.DecodedToHuman(&parsedData, registry)
+	if err != nil {
+		log.Panicf("Error when converting human, %v\n", err)
+	}
+	fmt.Printf("%v %v\n", decoded.Profile.Model, decoded.Profile.Family)
+	for _, havl := range decoded.Data[0][:3] {
+		val, _ := havl.GetFinalValue()
+		fmt.Printf("Property Name: %v, Value: %v\n", havl.AvlEncodeKey.PropertyName, val)
+	}
+
+	// Output:
+	// FMB920 FMBXY
+	// Property Name: Ignition, Value: 0
+	// Property Name: Movement, Value: 0
+	// Property Name: GSM Signal, Value: 5
+}
+
+func TestRegistryLookup(t *testing.T) {
+	registry := NewRegistry()
+	profiles := map[string]DeviceProfile{
+		"352094081672179": {"FMB640", "FM64"},
+		"35209408":        {"FM1100", "FM11XY"},
+		"3520":            {"FMB920", "FMBXY"},
+	}
+	for imei, profile := range profiles {
+		if err := registry.Set(imei, profile); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		imei string
+		want string
+		ok   bool
+	}{
+		{"352094081672179", "FMB640", true},
+		{"352094081672180", "FM1100", true},
+		{"352093085698206", "FMB920", true},
+		{"862094081672179", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		profile, ok := registry.Lookup(tt.imei)
+		if ok != tt.ok || profile.Model != tt.want {
+			t.Errorf("%v: want %v %v, got %v %v", tt.imei, tt.want, tt.ok, profile.Model, ok)
+		}
+	}
+
+	registry.Delete("35209408")
+	if profile, _ := registry.Lookup("352094081672180"); profile.Model != "FMB920" {
+		t.Errorf("want FMB920 after delete, got %v", profile.Model)
+	}
+
+	for _, imei := range []string{"", "35209408167217900", "35209A"} {
+		if err := registry.Set(imei, DeviceProfile{"FMB920", "FMBXY"}); err == nil {
+			t.Errorf("%q: want invalid IMEI error", imei)
+		}
+	}
+	if err := registry.Set("3520", DeviceProfile{Model: "XYZ100"}); err == nil {
+		t.Error("want missing family error")
+	}
+
+	// 16 digits IMEI is accepted by DecodeUDP
+	if err := registry.Set("3520940816721790", DeviceProfile{"FMB640", "FM64"}); err != nil {
+		t.Errorf("want 16 digits IMEI, got %v", err)
+	}
+	if profile, _ := registry.Lookup("3520940816721790"); profile.Model != "FMB640" {
+		t.Errorf("want FMB640 of 16 digits IMEI, got %v", profile.Model)
+	}
+
+	// family is resolved from the catalogue
+	if err := registry.Set("3521", DeviceProfile{Model: "fmc130"}); err != nil {
+		t.Fatal(err)
+	}
+	if profile, _ := registry.Lookup("352100000000000"); profile.Family != FamilyFMBXY {
+		t.Errorf("want FMBXY from the catalogue, got %v", profile.Family)
+	}
+}
+
+func TestRegistryLoad(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.LoadJSON(strings.NewReader(`{"352094081672179": {"Model": "FM3612", "Family": "FM36"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	if profile, ok := registry.Lookup("352094081672179"); !ok || profile.Family != "FM36" {
+		t.Errorf("want FM36, got %v", profile)
+	}
+
+	invalid := []string{
+		`{"352094081672180": {"Model": "FM3612", "Family": "FM36"}, "IMEI": {"Model": "FM3612", "Family": "FM36"}}`,
+		`{"352094081672180": {"Model": "XYZ100"}}`,
+		`[]`,
+	}
+	for _, s := range invalid {
+		if err := registry.LoadJSON(strings.NewReader(s)); err == nil {
+			t.Errorf("%v: want error", s)
+		}
+	}
+	if _, ok := registry.Lookup("352094081672180"); ok || registry.Len() != 1 {
+		t.Errorf("invalid registry must not be added, got %v profiles", registry.Len())
+	}
+
+	if err := registry.LoadCSV(strings.NewReader("IMEI,Family\n352094081672180,FM36\n")); err == nil {
+		t.Error("want missing Model column error")
+	}
+	if err := registry.LoadCSV(strings.NewReader("IMEI,Model\n352094081672180,FM3612\n")); err != nil {
+		t.Error(err)
+	}
+	if profile, _ := registry.Lookup("352094081672180"); profile.Family != FamilyFM36 {
+		t.Errorf("want FM36 from the catalogue, got %v", profile.Family)
+	}
+	if err := registry.LoadFile("registry.txt"); err == nil {
+		t.Error("want unknown format error")
+	}
+}
+
+func TestDecodedToHuman(t *testing.T) {
+	decoded := decodeDetectPacket(t, detectFM11Packet)
+	humanDecoder := HumanDecoder{}
+
+	// unknown IMEI, the family is detected
+	hd, err := humanDecoder.DecodedToHuman(&decoded, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hd.Detected || hd.Profile.Family != "FM11XY" || len(hd.Data) != len(decoded.Data) {
+		t.Errorf("want detected FM11XY, got %+v", hd.Profile)
+	}
+
+	// unknown family in registry
+	registry := NewRegistry()
+	registry.Set(decoded.IMEI, DeviceProfile{"FMC130", "FMC13X"})
+	if _, err := humanDecoder.DecodedToHuman(&decoded, registry); err == nil {
+		t.Error("want unknown family error")
+	}
+
+	registry.Set(decoded.IMEI, DeviceProfile{"FM1100", "FM11XY"})
+	hd, err = humanDecoder.DecodedToHuman(&decoded, registry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hd.Detected || hd.Profile.Model != "FM1100" || len(hd.Data[0]) != len(decoded.Data[0].Elements) {
+		t.Errorf("want FM1100 with all elements, got %+v with %v elements", hd.Profile, len(hd.Data[0]))
+	}
+}
+
+func TestDecodedToHumanWithoutIMEI(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+
+	// TCP frames of two different devices, IMEI is known only from the login packet
+	for _, tt := range []struct {
+		packet string
+		family string
+	}{
+		{detectFMBPacket, FamilyFMBXY},
+		{detectFMBPacket, FamilyFMBXY},
+		{detectFM11Packet, FamilyFM11XY},
+	} {
+		decoded := decodeDetectPacket(t, tt.packet)
+		decoded.IMEI = ""
+		hd, err := humanDecoder.DecodedToHuman(&decoded, NewRegistry())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !hd.Detected || hd.Profile.Family != tt.family {
+			t.Errorf("want detected %v, got %+v", tt.family, hd.Profile)
+		}
+	}
+
+	if _, ok := humanDecoder.CachedFamily(""); ok {
+		t.Error("want no cached decision of empty IMEI")
+	}
+}
+
+func TestRegistryConcurrent(t *testing.T) {
+	registry := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				imei := fmt.Sprintf("3520940816%05d", i*100+j)
+				registry.Set(imei, DeviceProfile{"FMB920", "FMBXY"})
+				registry.Lookup(imei)
+				if j%2 == 0 {
+					registry.Delete(imei)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if registry.Len() != 400 {
+		t.Errorf("want 400 profiles, got %v", registry.Len())
+	}
+}
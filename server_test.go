@@ -0,0 +1,245 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestTCPServer(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan Decoded, 1)
+	commands := make(chan Command, 1)
+	server := &TCPServer{
+		Handler:        func(d Decoded) { received <- d },
+		CommandHandler: func(imei string, cmd Command) { commands <- cmd },
+		Accept:         func(imei string) bool { return imei == "356307042441013" },
+		ReadTimeout:    5 * time.Second,
+		ErrorLog:       log.New(io.Discard, "", 0),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(ctx, l) }()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+	// IMEI login
+	login, _ := hex.DecodeString("000F333536333037303432343431303133")
+	conn.Write(login)
+	expectRead(t, conn, []byte{0x01})
+
+	// command is sent over the open session, the response is delivered to CommandHandler and not acknowledged
+	if err := server.SendCommand("352094089397464", Command{Text: "getinfo"}); err == nil {
+		t.Error("want error for IMEI without session")
+	}
+	if err := server.SendCommand("356307042441013", Command{Text: "getinfo"}); err != nil {
+		t.Fatal(err)
+	}
+	expectRead(t, conn, EncodeCodec12("getinfo"))
+	conn.Write(encodeTCPFrame(encodeCommandData(0x0C, CommandTypeResponse, []byte("RTC:2019/7/22 7:53"))))
+	select {
+	case cmd := <-commands:
+		if cmd.Type != CommandTypeResponse || cmd.Text != "RTC:2019/7/22 7:53" {
+			t.Errorf("unexpected command %+v", cmd)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("command handler was not called")
+	}
+
+	// corrupted frame is not accepted
+	frame, _ := hex.DecodeString("000000000000004308020000016B40D57B480100000000000000000000000000000001010101000000000000016B40D5C198010000000000000000000000000000000101010101000000020000252C")
+	corrupted := append([]byte{}, frame...)
+	corrupted[len(corrupted)-1]++
+	conn.Write(corrupted)
+	expectRead(t, conn, []byte{0x00, 0x00, 0x00, 0x00})
+
+	// valid frame is delivered and acknowledged, it is sent in two parts
+	conn.Write(frame[:20])
+	time.Sleep(10 * time.Millisecond)
+	conn.Write(frame[20:])
+	expectRead(t, conn, []byte{0x00, 0x00, 0x00, 0x02})
+
+	select {
+	case d := <-received:
+		if d.IMEI != "356307042441013" || d.NoOfData != 2 {
+			t.Errorf("unexpected decoded packet %+v", d)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("handler was not called")
+	}
+
+	// unknown device is rejected
+	other, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	other.SetDeadline(time.Now().Add(5 * time.Second))
+	login, _ = hex.DecodeString("000F333532303934303839333937343634")
+	other.Write(login)
+	expectRead(t, other, []byte{0x00})
+
+	// shutdown closes open sessions
+	cancel()
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("Serve returned %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after shutdown")
+	}
+	if _, err := conn.Read(make([]byte, 1)); err == nil {
+		t.Error("session was not closed on shutdown")
+	}
+}
+
+func expectRead(t *testing.T, r io.Reader, want []byte) {
+	t.Helper()
+	got := make([]byte, len(want))
+	if _, err := io.ReadFull(r, got); err != nil {
+		t.Fatalf("reading response failed, %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("want response %x, got %x", want, got)
+	}
+}
+
+func TestUDPServer(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan Decoded, 3)
+	server := &UDPServer{
+		Handler:  func(d Decoded) { received <- d },
+		ErrorLog: log.New(io.Discard, "", 0),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- server.Serve(ctx, conn) }()
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	client.SetDeadline(time.Now().Add(5 * time.Second))
+
+	packet, _ := hex.DecodeString("005FCAFE0107000F3335323039333038353639383230360801000001669BF6FAB80000000000000000000000000000000000020200EF00F000000001")
+
+	// the second packet is a retransmission with the same AVL packet ID, the third one is a new packet
+	for i, avlPacketID := range []byte{0x07, 0x07, 0x08} {
+		packet[5] = avlPacketID
+		client.Write(packet)
+
+		response := make([]byte, 16)
+		n, err := client.Read(response)
+		if err != nil {
+			t.Fatalf("packet %v: reading response failed, %v", i, err)
+		}
+		if want := []byte{0x00, 0x05, 0xCA, 0xFE, 0x01, avlPacketID, 0x01}; !bytes.Equal(response[:n], want) {
+			t.Errorf("packet %v: want response %x, got %x", i, want, response[:n])
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-served:
+		if err != nil {
+			t.Errorf("Serve returned %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after shutdown")
+	}
+
+	close(received)
+	var ids []byte
+	for d := range received {
+		if d.IMEI != "352093085698206" {
+			t.Errorf("unexpected IMEI %v", d.IMEI)
+		}
+		ids = append(ids, d.AvlPacketID)
+	}
+	if !bytes.Equal(ids, []byte{0x07, 0x08}) {
+		t.Errorf("want delivered AVL packet IDs 0708, got %x", ids)
+	}
+}
+
+func TestUDPServerPackets(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	packets := make(chan Decoded)
+	server := &UDPServer{
+		Packets:  packets,
+		ErrorLog: log.New(io.Discard, "", 0),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go server.Serve(ctx, conn)
+
+	client, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	packet, _ := hex.DecodeString("005FCAFE0107000F3335323039333038353639383230360801000001669BF6FAB80000000000000000000000000000000000020200EF00F000000001")
+	client.Write(packet)
+
+	select {
+	case d := <-packets:
+		if d.IMEI != "352093085698206" || d.AvlPacketID != 0x07 {
+			t.Errorf("unexpected packet %v %x", d.IMEI, d.AvlPacketID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("packet was not delivered to the channel")
+	}
+}
+
+func TestUDPServerIdleIMEI(t *testing.T) {
+	server := &UDPServer{IdleTimeout: 20 * time.Millisecond}
+	first := Decoded{IMEI: "352093085698206", AvlPacketID: 0x07}
+	second := Decoded{IMEI: "352094081672179", AvlPacketID: 0x01}
+
+	if server.isDuplicate(&first) || !server.isDuplicate(&first) {
+		t.Fatal("want the second packet with the same AVL packet ID to be a duplicate")
+	}
+
+	// after the timeout the same AVL packet ID is a new packet and the idle IMEI is removed
+	time.Sleep(30 * time.Millisecond)
+	if server.isDuplicate(&second) {
+		t.Error("want a new IMEI not to be a duplicate")
+	}
+	if _, ok := server.lastPacketID[first.IMEI]; ok {
+		t.Error("want idle IMEI to be forgotten")
+	}
+	if server.isDuplicate(&first) {
+		t.Error("want a packet of a forgotten IMEI not to be a duplicate")
+	}
+}
@@ -0,0 +1,247 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sync"
+	"time"
+)
+
+// Handler is called by a server with every successfully decoded packet
+type Handler func(Decoded)
+
+// TCPServer accepts TCP sessions of Teltonika devices, performs IMEI login handshake, decodes received frames,
+// acknowledges them and delivers them to the Handler. AVL frames sent over TCP do not carry IMEI,
+// so every delivered Decoded is tagged with IMEI of the session. Commands are sent to open sessions by SendCommand,
+// command frames received from devices are delivered to the CommandHandler.
+type TCPServer struct {
+	Addr         string            // TCP address to listen on by ListenAndServe
+	Handler      Handler           // Handler called with every decoded frame, it is called from the goroutine of the session
+	Accept       func(string) bool // Accept is called with IMEI of a new session, session is rejected if it returns false, nil accepts all devices
+	ReadTimeout  time.Duration     // Maximum duration of waiting for IMEI or for the next frame, zero means no timeout
+	MaxFrameSize int               // Maximum size of a frame, the session is closed when a device declares larger frame, zero means DefaultMaxFrameSize
+	ErrorLog     *log.Logger       // Logger for errors of sessions, if nil the log package's standard logger is used
+
+	// CommandHandler is called with IMEI and every command frame of codec 12, 13 or 14 received from a device,
+	// typically a response to SendCommand, it is called from the goroutine of the session
+	CommandHandler func(imei string, cmd Command)
+
+	mu       sync.Mutex
+	sessions map[string]*tcpSession // open sessions by IMEI
+}
+
+// tcpSession is an open session of a device, writes of acknowledgements and commands are serialized
+type tcpSession struct {
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// write writes bs to the connection of the session
+func (t *tcpSession) write(bs []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, err := t.conn.Write(bs)
+	return err
+}
+
+// SendCommand encodes cmd by EncodeCommand and sends it to the open session of imei, zero CodecID means codec 12,
+// codec 14 command without IMEI is addressed to imei. The response of the device is delivered to the CommandHandler
+func (s *TCPServer) SendCommand(imei string, cmd Command) error {
+	if cmd.CodecID == 0x0E && cmd.IMEI == "" {
+		cmd.IMEI = imei
+	}
+	frame, err := EncodeCommand(cmd)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	session, ok := s.sessions[imei]
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("Unable to send command, IMEI %v has no open session", imei)
+	}
+	return session.write(frame)
+}
+
+// addSession registers an open session of imei, a newer session of the same IMEI replaces the older one
+func (s *TCPServer) addSession(imei string, session *tcpSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sessions == nil {
+		s.sessions = make(map[string]*tcpSession)
+	}
+	s.sessions[imei] = session
+}
+
+// removeSession unregisters session of imei unless it was already replaced by a newer one
+func (s *TCPServer) removeSession(imei string, session *tcpSession) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sessions[imei] == session {
+		delete(s.sessions, imei)
+	}
+}
+
+// ListenAndServe listens on the TCP address s.Addr and calls Serve
+func (s *TCPServer) ListenAndServe(ctx context.Context) error {
+	l, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, l)
+}
+
+// Serve accepts incoming connections on the Listener l and serves every session in its own goroutine.
+// When ctx is done, the listener and all open sessions are closed and Serve returns nil after all sessions are finished.
+func (s *TCPServer) Serve(ctx context.Context, l net.Listener) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	// close the listener on shutdown to unblock Accept
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				continue
+			}
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.serveConn(ctx, conn)
+		}()
+	}
+}
+
+// serveConn serves one device session until the connection is closed or ctx is done
+func (s *TCPServer) serveConn(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	// close the connection on shutdown to unblock reading
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	reader := NewFrameReader(conn, s.MaxFrameSize)
+
+	// IMEI login handshake
+	s.setReadDeadline(conn)
+	login, err := reader.ReadIMEIPacket()
+	if err != nil {
+		s.logf("teltonikaparser: reading IMEI from %v failed, %v", conn.RemoteAddr(), err)
+		return
+	}
+	imei, err := DecodeIMEI(&login)
+	if err != nil || (s.Accept != nil && !s.Accept(imei)) {
+		if err != nil {
+			s.logf("teltonikaparser: session from %v rejected, %v", conn.RemoteAddr(), err)
+		}
+		conn.Write(IMEIResponse(false))
+		return
+	}
+
+	// the session is registered before the IMEI response is written, so that no command can precede it
+	session := &tcpSession{conn: conn}
+	session.mu.Lock()
+	s.addSession(imei, session)
+	defer s.removeSession(imei, session)
+	_, err = conn.Write(IMEIResponse(true))
+	session.mu.Unlock()
+	if err != nil {
+		s.logf("teltonikaparser: IMEI %v, writing IMEI response failed, %v", imei, err)
+		return
+	}
+
+	for {
+		s.setReadDeadline(conn)
+
+		frame, err := reader.ReadFrame()
+		if err != nil {
+			if ctx.Err() == nil && err != io.EOF {
+				s.logf("teltonikaparser: IMEI %v, reading frame failed, %v", imei, err)
+			}
+			return
+		}
+
+		// command frames are not acknowledged
+		if isCommandFrame(frame) {
+			cmd, err := DecodeCommand(&frame)
+			if err != nil {
+				s.logf("teltonikaparser: IMEI %v, %v", imei, err)
+				continue
+			}
+			if s.CommandHandler != nil {
+				s.CommandHandler(imei, cmd)
+			}
+			continue
+		}
+
+		decoded, err := DecodeTCP(&frame)
+		if err != nil {
+			// the frame was read entirely, so the stream is still synchronized, zero accepted data makes the device resend it
+			s.logf("teltonikaparser: IMEI %v, %v", imei, err)
+			rejected := Decoded{Transport: TransportTCP}
+			if err := session.write(rejected.BuildResponse(0)); err != nil {
+				return
+			}
+			continue
+		}
+		decoded.IMEI = imei
+
+		if s.Handler != nil {
+			s.Handler(decoded)
+		}
+
+		if len(decoded.Response) > 0 {
+			if err := session.write(decoded.Response); err != nil {
+				s.logf("teltonikaparser: IMEI %v, writing response failed, %v", imei, err)
+				return
+			}
+		}
+	}
+}
+
+// setReadDeadline sets a deadline for the next read if ReadTimeout is set
+func (s *TCPServer) setReadDeadline(conn net.Conn) {
+	if s.ReadTimeout > 0 {
+		conn.SetReadDeadline(time.Now().Add(s.ReadTimeout))
+	}
+}
+
+// logf logs an error of a session to ErrorLog
+func (s *TCPServer) logf(format string, args ...interface{}) {
+	if s.ErrorLog != nil {
+		s.ErrorLog.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
@@ -66,6 +66,8 @@ const FM11XY string = `{
 	   "Description":"Voltage: mV, 0 – 30 V",
 	   "Parametr Group":"M",
 	   "Type":"Unsigned",
+	   "Multiplier":"0.001",
+	   "Units":"V",
 	   "FinalConversion":"toUint16"
 	},
 	"69":{
@@ -74,6 +76,7 @@ const FM11XY string = `{
 	   "Description":"States: 0 – GPS module is turned off, 2 – working, but no fix, 3 – working with GPS fix, 4 – GPS module is in sleep state, 5 – antenna is short circuit",
 	   "Parametr Group":"M",
 	   "Type":"Unsigned",
+	   "Values":{"0":"GPS Module Is Turned Off","2":"Working, But No Fix","3":"Working With GPS Fix","4":"GPS Module Is In Sleep State","5":"Antenna Is Short Circuit"},
 	   "FinalConversion":"toUint8"
 	},
 	"71":{
@@ -88,28 +91,40 @@ const FM11XY string = `{
 	   "Bytes":"4",
 	   "Description":"10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error",
 	   "Parametr Group":"M",
-	   "FinalConversion":"to[]byte"
+	   "Type":"Signed",
+	   "Multiplier":"0.1",
+	   "Units":"°C",
+	   "FinalConversion":"toInt32"
 	},
 	"73":{
 	   "PropertyName":"Dallas Temperature 2",
 	   "Bytes":"4",
 	   "Description":"10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error",
 	   "Parametr Group":"M",
-	   "FinalConversion":"to[]byte"
+	   "Type":"Signed",
+	   "Multiplier":"0.1",
+	   "Units":"°C",
+	   "FinalConversion":"toInt32"
 	},
 	"74":{
 	   "PropertyName":"Dallas Temperature 3",
 	   "Bytes":"4",
 	   "Description":"10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error",
 	   "Parametr Group":"M",
-	   "FinalConversion":"to[]byte"
+	   "Type":"Signed",
+	   "Multiplier":"0.1",
+	   "Units":"°C",
+	   "FinalConversion":"toInt32"
 	},
 	"75":{
 	   "PropertyName":"Dallas Temperature 4",
 	   "Bytes":"4",
 	   "Description":"10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error",
 	   "Parametr Group":"M",
-	   "FinalConversion":"to[]byte"
+	   "Type":"Signed",
+	   "Multiplier":"0.1",
+	   "Units":"°C",
+	   "FinalConversion":"toInt32"
 	},
 	"76":{
 	   "PropertyName":"Dallas Temperature ID 1",
@@ -145,6 +160,7 @@ const FM11XY string = `{
 	   "Description":"0 – home on stop, 1 – home on move, 2 – roaming on stop, 3 – roaming on move, 4 – unknown on stop, 5 – unknown on move",
 	   "Parametr Group":"M",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Home On Stop","1":"Home On Move","2":"Roaming On Stop","3":"Roaming On Move","4":"Unknown On Stop","5":"Unknown On Move"},
 	   "FinalConversion":"toUint8"
 	},
 	"179":{
@@ -193,6 +209,7 @@ const FM11XY string = `{
 	   "Description":"0 – not deep sleep mode, 1 – deep sleep mode",
 	   "Parametr Group":"M",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Not Deep Sleep Mode","1":"Deep Sleep Mode"},
 	   "FinalConversion":"toUint8"
 	},
 	"205":{
@@ -217,6 +234,7 @@ const FM11XY string = `{
 	   "Description":"0 – ignition off, 1 – ignition on",
 	   "Parametr Group":"M",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Ignition Off","1":"Ignition On"},
 	   "FinalConversion":"toUint8"
 	},
 	"240":{
@@ -225,6 +243,7 @@ const FM11XY string = `{
 	   "Description":"0 – not moving, 1 – moving",
 	   "Parametr Group":"M",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Not Moving","1":"Moving"},
 	   "FinalConversion":"toUint8"
 	},
 	"241":{
@@ -257,6 +276,8 @@ const FM11XY string = `{
 	   "Description":"Value in liters multiplied by 10, L*10",
 	   "Parametr Group":"A2",
 	   "Type":"Unsigned",
+	   "Multiplier":"0.1",
+	   "Units":"l",
 	   "FinalConversion":"toUint32"
 	},
 	"84":{
@@ -297,6 +318,7 @@ const FM11XY string = `{
 	   "Description":"Door status value: Min – 0, Max – 16128 Door status is represented as bitmask converted to decimal value. Possible values: 0 – all doors closed, 0x100 (256) – front left door is opened, 0x200 (512) – front right door is opened, 0x400 (1024) – rear left door is opened, 0x800 (2048) – rear right door is opened, 0x1000 (4096) – hood is opened, 0x2000 (8192) – trunk is opened, 0x3F00 (16128) – all doors are opened, or combinations of values",
 	   "Parametr Group":"A2",
 	   "Type":"Unsigned",
+	   "Bits":{"8":"Front left door open","9":"Front right door open","10":"Rear left door open","11":"Rear right door open","12":"Hood open","13":"Trunk open"},
 	   "FinalConversion":"toUint16"
 	},
 	"100":{
@@ -352,6 +374,8 @@ const FM11XY string = `{
 	   "Description":"Fuel Rata, liters *10",
 	   "Parametr Group":"A2",
 	   "Type":"Unsigned",
+	   "Multiplier":"0.1",
+	   "Units":"l/h",
 	   "FinalConversion":"toUint16"
 	},
 	"111":{
@@ -384,6 +408,8 @@ const FM11XY string = `{
 	   "Description":"Engine Temperature, 10 * Degrees ( °C ),",
 	   "Parametr Group":"A2",
 	   "Type":"Signed",
+	   "Multiplier":"0.1",
+	   "Units":"°C",
 	   "FinalConversion":"toInt16"
 	},
 	"118":{
@@ -431,6 +457,7 @@ const FM11XY string = `{
 	   "Bytes":"4",
 	   "Description":"Control state flags Byte0 (LSB): 0x01 – STOP 0x02 – Oil pressure / level 0x04 – Coolant liquid temperature / level 0x08 – Handbrake system 0x10 – Battery charging 0x20 – AIRBAG Byte1:0x01 – CHECK ENGINE 0x02 – Lights failure 0x04 – Low tire pressure 0x08 – Wear of brake pads 0x10 – Warning 0x20 – ABS 0x40 – Low Fuel Byte2:0x01 – ESP 0x02 – Glow plug indicator 0x04 – FAP 0x08 – Electronics pressure control 0x10 – Parking lights 0x20 – Dipped headlights 0x40 – Full beam headlights Byte3: 0x40 – Passenger's seat belt 0x80 – Driver's seat belt",
 	   "Parametr Group":"A2",
+	   "Bits":{"0":"STOP","1":"Oil pressure / level","2":"Coolant liquid temperature / level","3":"Handbrake system","4":"Battery charging","5":"AIRBAG","8":"CHECK ENGINE","9":"Lights failure","10":"Low tire pressure","11":"Wear of brake pads","12":"Warning","13":"ABS","14":"Low Fuel","16":"ESP","17":"Glow plug indicator","18":"FAP","19":"Electronics pressure control","20":"Parking lights","21":"Dipped headlights","22":"Full beam headlights","30":"Passenger's seat belt","31":"Driver's seat belt"},
 	   "FinalConversion":"to[]byte"
 	},
 	"124":{
@@ -438,6 +465,7 @@ const FM11XY string = `{
 	   "Bytes":"8",
 	   "Description":"Agricultural machinery flags Byte0 (LSB): 0x01 – Mowing 0x02 – Grain release from hopper 0x04 – First front hydraulic turned on 0x08 – Rear Power Take-Off turned on Byte1: 0x01 – Excessive play under the threshing drum 0x02 – Grain tank is open 0x04 – 100% of Grain tank 0x08 – 70% of Grain tank 0x10 – Drain filter in hydraulic system of drive cylinders is plugged 0x20 – Pressure filter of drive cylinders hydraulic system is plugged 0x40 – Alarm oil level in oil tank 0x80 – Pressure filter of brakes hydraulic system is plugged Byte2: 0x01 – Oil filter of engine is plugged 0x02 – Fuel filter is plugged 0x04 – Air filter is plugged 0x08 – Alarm oil temperature in hydraulic system of chasis 0x10 – Alarm oil temperature in hydraulic system of drive cylinders 0x20 – Alarm oil pressure in engine 0x40 – Alarm coolant level 0x80 – Overflow chamber of hydraulic unit Byte3: 0x01 – Unloader drive is ON. Unloading tube pivot is in idle position 0x02 – No operator! 0x04 – Straw walker is plugged 0x08 – Water in fuel 0x10 – Cleaning fan RPM 0x20 – Trashing drum RPM Byte4:0x02 – Low water level in the tank 0x04 – First rear hydraulic turned on 0x08 – Standalone engine working 0x10 – Right joystick moved right 0x20 – Right joystick moved left 0x40 – Right joystick moved front 0x80 – Right joystick moved back Byte5: 0x01 – Brushes turned on 0x02 – Water supply turned on 0x04 – Vacuum cleaner  0x08 – Unloading from the hopper 0x10 – High Pressure washer (Karcher) 0x20 – Salt (sand) disperser ON 0x40 – Low salt (sand) level Byte6: 0x01 – Second front hydraulic turned on 0x02 – Third front hydraulic turned on 0x04 – Fourth front hydraulic turned on 0x08 – Second rear hydraulic turned on 0x10 – Third rear hydraulic turned on 0x20 – Fourth rear hydraulic turned on 0x40 – Front three-point Hitch turned on 0x80 – Rear three-point Hitch turned on Byte7:0x01 – Left joystick moved right 0x02 – Left joystick moved left 0x04 – Left joystick moved front 0x08 – Left joystick moved back 0x10 – Front Power Take-Off turned on",
 	   "Parametr Group":"A2",
+	   "Bits":{"0":"Mowing","1":"Grain release from hopper","2":"First front hydraulic turned on","3":"Rear Power Take-Off turned on","8":"Excessive play under the threshing drum","9":"Grain tank is open","10":"100% of Grain tank","11":"70% of Grain tank","12":"Drain filter in hydraulic system of drive cylinders is plugged","13":"Pressure filter of drive cylinders hydraulic system is plugged","14":"Alarm oil level in oil tank","15":"Pressure filter of brakes hydraulic system is plugged","16":"Oil filter of engine is plugged","17":"Fuel filter is plugged","18":"Air filter is plugged","19":"Alarm oil temperature in hydraulic system of chasis","20":"Alarm oil temperature in hydraulic system of drive cylinders","21":"Alarm oil pressure in engine","22":"Alarm coolant level","23":"Overflow chamber of hydraulic unit","24":"Unloader drive is ON","25":"No operator","26":"Straw walker is plugged","27":"Water in fuel","28":"Cleaning fan RPM","29":"Trashing drum RPM","33":"Low water level in the tank","34":"First rear hydraulic turned on","35":"Standalone engine working","36":"Right joystick moved right","37":"Right joystick moved left","38":"Right joystick moved front","39":"Right joystick moved back","40":"Brushes turned on","41":"Water supply turned on","42":"Vacuum cleaner","43":"Unloading from the hopper","44":"High Pressure washer (Karcher)","45":"Salt (sand) disperser ON","46":"Low salt (sand) level","48":"Second front hydraulic turned on","49":"Third front hydraulic turned on","50":"Fourth front hydraulic turned on","51":"Second rear hydraulic turned on","52":"Third rear hydraulic turned on","53":"Fourth rear hydraulic turned on","54":"Front three-point Hitch turned on","55":"Rear three-point Hitch turned on","56":"Left joystick moved right","57":"Left joystick moved left","58":"Left joystick moved front","59":"Left joystick moved back","60":"Front Power Take-Off turned on"},
 	   "FinalConversion":"to[]byte"
 	},
 	"125":{
@@ -501,6 +529,7 @@ const FM11XY string = `{
 	   "Bytes":"8",
 	   "Description":"Security State Flag Byte0 (LSB): Every two bits in this byte correspond to a different CAN bus number. 00 – CAN not connected, connection not required 01 – CAN connected, but currently module not received data 10 – CAN not connected, require connection 11 – CAN connectedExample: Byte0 - 0F hex – 00001111 binary CAN4, CAN3, CAN2, CAN1 Byte1: Not used Byte2: 0x20 – bit appears when any operate button in car was put 0x40 – bit appears when immobilizer is in service mode 0x80 – immobiliser, bit appears during introduction of a programmed sequence of keys in the car. Byte3: 0x01 – the key is in ignition lock 0x02 – ignition on 0x04 – dynamic ignition on 0x08 – webasto 0x20 – car closed by factory's remote control 0x40 – factory-installed alarm system is actuated (is in panic mode) 0x80 – factory-installed alarm system is emulated by module Byte4: 0x01 – parking activated (automatic gearbox) 0x10 – handbrake is actuated (information available only with ignition on) 0x20 – footbrake is actuated (information available only with ignition on) 0x40 – engine is working (information available only when the ignition on) 0x80 – revers is on Byte5: 0x01 – Front left door opened 0x02 – Front right door opened 0x04 – Rear left door opened 0x08 – Rear right door opened 0x10 – engine cover opened 0x20 – trunk door opened Byte6: 0x01 – car was closed by the factory's remote control 0x02 – car was opened by the factory's remote control 0x03 – trunk cover was opened by the factory's remote control 0x04 – module has sent a rearming signal 0x05 – car was closed three times by the factory's remote control - High nibble (mask 0xF0 bit) 0x80 – CAN module goes to sleep mode Byte7: Not used",
 	   "Parametr Group":"A2",
+	   "Bits":{"0":"CAN1 connected","1":"CAN1 required","2":"CAN2 connected","3":"CAN2 required","4":"CAN3 connected","5":"CAN3 required","6":"CAN4 connected","7":"CAN4 required","21":"Operate button pressed","22":"Immobilizer in service mode","23":"Immobilizer key sequence","24":"Key in ignition lock","25":"Ignition on","26":"Dynamic ignition on","27":"Webasto","29":"Car closed by factory remote control","30":"Factory alarm actuated","31":"Factory alarm emulated by module","32":"Parking activated","36":"Handbrake actuated","37":"Footbrake actuated","38":"Engine working","39":"Reverse on","40":"Front left door open","41":"Front right door open","42":"Rear left door open","43":"Rear right door open","44":"Hood open","45":"Trunk open","55":"CAN module sleep mode"},
 	   "FinalConversion":"to[]byte"
 	},
 	"133":{
@@ -865,6 +894,7 @@ const FM11XY string = `{
 	   "Description":"0 – engine not on CNG 1 – engine on CNG",
 	   "Parametr Group":"O",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Engine Not On CNG","1":"Engine On CNG"},
 	   "FinalConversion":"toUint8"
 	},
 	"191":{
@@ -889,6 +919,7 @@ const FM11XY string = `{
 	   "Description":"0 – Oil level/pressure warning off 1 – Oil level/pressure warning on",
 	   "Parametr Group":"O",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Oil Level/Pressure Warning Off","1":"Oil Level/Pressure Warning On"},
 	   "FinalConversion":"toUint8"
 	},
 	"155":{
@@ -897,6 +928,7 @@ const FM11XY string = `{
 	   "Description":"Event: 0 – target left zone, 1 – target entered zone",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"156":{
@@ -905,6 +937,7 @@ const FM11XY string = `{
 	   "Description":"Event: 0 – target left zone, 1 – target entered zone",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"157":{
@@ -913,6 +946,7 @@ const FM11XY string = `{
 	   "Description":"Event: 0 – target left zone, 1 – target entered zone",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"158":{
@@ -921,6 +955,7 @@ const FM11XY string = `{
 	   "Description":"Event: 0 – target left zone, 1 – target entered zone",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"159":{
@@ -929,6 +964,7 @@ const FM11XY string = `{
 	   "Description":"Event: 0 – target left zone, 1 – target entered zone",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"175":{
@@ -937,6 +973,7 @@ const FM11XY string = `{
 	   "Description":"Event: 0 – target left zone, 1 – target entered zone",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"249":{
@@ -945,6 +982,7 @@ const FM11XY string = `{
 	   "Description":"1 – jamming start, 0 – jamming stop",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Jamming Stop","1":"Jamming Start"},
 	   "FinalConversion":"toUint8"
 	},
 	"250":{
@@ -953,6 +991,7 @@ const FM11XY string = `{
 	   "Description":"1 – trip start, 0 – trip stop",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"0":"Trip Stop","1":"Trip Start"},
 	   "FinalConversion":"toUint8"
 	},
 	"251":{
@@ -961,6 +1000,7 @@ const FM11XY string = `{
 	   "Description":"1 – iButton connected",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"1":"iButton Connected"},
 	   "FinalConversion":"toUint8"
 	},
 	"252":{
@@ -969,6 +1009,7 @@ const FM11XY string = `{
 	   "Description":"1 – authorized iButton connected",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"1":"Authorized iButton Connected"},
 	   "FinalConversion":"toUint8"
 	},
 	"253":{
@@ -977,6 +1018,7 @@ const FM11XY string = `{
 	   "Description":"1 – harsh acceleration, 2 – harsh braking, 3 – harsh cornering",
 	   "Parametr Group":"ME",
 	   "Type":"Unsigned",
+	   "Values":{"1":"Harsh Acceleration","2":"Harsh Braking","3":"Harsh Cornering"},
 	   "FinalConversion":"toUint8"
 	},
 	"254":{
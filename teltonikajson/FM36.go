@@ -123,8 +123,8 @@ const FM36 string = `{
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"30000",
-	   "Multiplier":"-",
-	   "Units":"mV",
+	   "Multiplier":"0.001",
+	   "Units":"V",
 	   "Description":"Voltage: mV, 0 – 30000 mV",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Permanent I/O elements",
@@ -170,6 +170,7 @@ const FM36 string = `{
 	   "Description":"States:0 – GPS module is power off.1 – GPS antenna is disconnected.2 – Working, no GPS FIX.3 – Working, GPS FIX acquired.4 – GPS sleep.5 – GPS antenna is short circuited.",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"GPS Module Power Off","1":"GPS Antenna Disconnected","2":"Working, No GPS Fix","3":"Working, GPS Fix Acquired","4":"GPS Sleep","5":"GPS Antenna Short Circuited"},
 	   "FinalConversion":"toUint8"
 	},
 	"72":{
@@ -179,7 +180,7 @@ const FM36 string = `{
 	   "Type":"Signed",
 	   "Min":"-55",
 	   "Max":"3000",
-	   "Multiplier":"10",
+	   "Multiplier":"0.1",
 	   "Units":"°C",
 	   "Description":"10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error",
 	   "HWSupport":"FM3612, FM36M1",
@@ -193,7 +194,7 @@ const FM36 string = `{
 	   "Type":"Signed",
 	   "Min":"-55",
 	   "Max":"3000",
-	   "Multiplier":"10",
+	   "Multiplier":"0.1",
 	   "Units":"°C",
 	   "Description":"10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error",
 	   "HWSupport":"FM3612, FM36M1",
@@ -207,7 +208,7 @@ const FM36 string = `{
 	   "Type":"Signed",
 	   "Min":"-55",
 	   "Max":"3000",
-	   "Multiplier":"10",
+	   "Multiplier":"0.1",
 	   "Units":"°C",
 	   "Description":"10 * Degrees ( °C ), -55 - +115, if 3000 – Dallas error",
 	   "HWSupport":"FM3612, FM36M1",
@@ -282,6 +283,7 @@ const FM36 string = `{
 	   "Description":"0 – 2G;2 – 3G;8 – LTE-M1;9 – NB-IOT.",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"2G","2":"3G","8":"LTE-M1","9":"NB-IOT"},
 	   "FinalConversion":"toUint8"
 	},
 	"80":{
@@ -296,6 +298,7 @@ const FM36 string = `{
 	   "Description":"0 – home on stop,1 – home on move,2 – roaming on stop,3 – roaming on move,4 – unknown on stop,5 – unknown on move",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Home On Stop","1":"Home On Move","2":"Roaming On Stop","3":"Roaming On Move","4":"Unknown On Stop","5":"Unknown On Move"},
 	   "FinalConversion":"toUint8"
 	},
 	"99":{
@@ -394,6 +397,7 @@ const FM36 string = `{
 	   "Description":"0 – not deep sleep mode,1 – deep sleep mode",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Not Deep Sleep Mode","1":"Deep Sleep Mode"},
 	   "FinalConversion":"toUint8"
 	},
 	"205":{
@@ -436,6 +440,7 @@ const FM36 string = `{
 	   "Description":"0 – ignition off,1 – ignition on",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Ignition Off","1":"Ignition On"},
 	   "FinalConversion":"toUint8"
 	},
 	"240":{
@@ -450,6 +455,7 @@ const FM36 string = `{
 	   "Description":"0 – not moving,1 – moving",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Not Moving","1":"Moving"},
 	   "FinalConversion":"toUint8"
 	},
 	"241":{
@@ -898,6 +904,7 @@ const FM36 string = `{
 	   "Description":"see LVCAN IO element values",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"ALLCAN300/LVCAN200 I/O elements",
+	   "Bits":{"0":"STOP","1":"Oil pressure / level","2":"Coolant liquid temperature / level","3":"Handbrake system","4":"Battery charging","5":"AIRBAG","8":"CHECK ENGINE","9":"Lights failure","10":"Low tire pressure","11":"Wear of brake pads","12":"Warning","13":"ABS","14":"Low Fuel","16":"ESP","17":"Glow plug indicator","18":"FAP","19":"Electronics pressure control","20":"Parking lights","21":"Dipped headlights","22":"Full beam headlights","30":"Passenger's seat belt","31":"Driver's seat belt"},
 	   "FinalConversion":"toUint32"
 	},
 	"124":{
@@ -912,6 +919,7 @@ const FM36 string = `{
 	   "Description":"see LVCAN IO element values",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"ALLCAN300/LVCAN200 I/O elements",
+	   "Bits":{"0":"Mowing","1":"Grain release from hopper","2":"First front hydraulic turned on","3":"Rear Power Take-Off turned on","8":"Excessive play under the threshing drum","9":"Grain tank is open","10":"100% of Grain tank","11":"70% of Grain tank","12":"Drain filter in hydraulic system of drive cylinders is plugged","13":"Pressure filter of drive cylinders hydraulic system is plugged","14":"Alarm oil level in oil tank","15":"Pressure filter of brakes hydraulic system is plugged","16":"Oil filter of engine is plugged","17":"Fuel filter is plugged","18":"Air filter is plugged","19":"Alarm oil temperature in hydraulic system of chasis","20":"Alarm oil temperature in hydraulic system of drive cylinders","21":"Alarm oil pressure in engine","22":"Alarm coolant level","23":"Overflow chamber of hydraulic unit","24":"Unloader drive is ON","25":"No operator","26":"Straw walker is plugged","27":"Water in fuel","28":"Cleaning fan RPM","29":"Trashing drum RPM","33":"Low water level in the tank","34":"First rear hydraulic turned on","35":"Standalone engine working","36":"Right joystick moved right","37":"Right joystick moved left","38":"Right joystick moved front","39":"Right joystick moved back","40":"Brushes turned on","41":"Water supply turned on","42":"Vacuum cleaner","43":"Unloading from the hopper","44":"High Pressure washer (Karcher)","45":"Salt (sand) disperser ON","46":"Low salt (sand) level","48":"Second front hydraulic turned on","49":"Third front hydraulic turned on","50":"Fourth front hydraulic turned on","51":"Second rear hydraulic turned on","52":"Third rear hydraulic turned on","53":"Fourth rear hydraulic turned on","54":"Front three-point Hitch turned on","55":"Rear three-point Hitch turned on","56":"Left joystick moved right","57":"Left joystick moved left","58":"Left joystick moved front","59":"Left joystick moved back","60":"Front Power Take-Off turned on"},
 	   "FinalConversion":"to[]byte"
 	},
 	"125":{
@@ -1024,6 +1032,7 @@ const FM36 string = `{
 	   "Description":"see LVCAN IO element values",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"ALLCAN300/LVCAN200 I/O elements",
+	   "Bits":{"0":"CAN1 connected","1":"CAN1 required","2":"CAN2 connected","3":"CAN2 required","4":"CAN3 connected","5":"CAN3 required","6":"CAN4 connected","7":"CAN4 required","21":"Operate button pressed","22":"Immobilizer in service mode","23":"Immobilizer key sequence","24":"Key in ignition lock","25":"Ignition on","26":"Dynamic ignition on","27":"Webasto","29":"Car closed by factory remote control","30":"Factory alarm actuated","31":"Factory alarm emulated by module","32":"Parking activated","36":"Handbrake actuated","37":"Footbrake actuated","38":"Engine working","39":"Reverse on","40":"Front left door open","41":"Front right door open","42":"Rear left door open","43":"Rear right door open","44":"Hood open","45":"Trunk open","55":"CAN module sleep mode"},
 	   "FinalConversion":"to[]byte"
 	},
 	"133":{
@@ -1318,6 +1327,7 @@ const FM36 string = `{
 	   "Description":"0 – all doors closed256 - front left door opened512 - front right door opened1024 - rear left door opened2048 - rear right door opened4096 - engine cover opened8192 - trunk door opened16128 - all doors opened",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"ALLCAN300/LVCAN200 I/O elements",
+	   "Bits":{"8":"Front left door open","9":"Front right door open","10":"Rear left door open","11":"Rear right door open","12":"Hood open","13":"Trunk open"},
 	   "FinalConversion":"toUint16"
 	},
 	"160":{
@@ -1724,6 +1734,7 @@ const FM36 string = `{
 	   "Description":"Event:0 – target left zone,1 – target entered zone",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"156":{
@@ -1738,6 +1749,7 @@ const FM36 string = `{
 	   "Description":"Event:0 – target left zone,1 – target entered zone",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"157":{
@@ -1752,6 +1764,7 @@ const FM36 string = `{
 	   "Description":"Event:0 – target left zone,1 – target entered zone",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"158":{
@@ -1766,6 +1779,7 @@ const FM36 string = `{
 	   "Description":"Event:0 – target left zone,1 – target entered zone",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"159":{
@@ -1780,6 +1794,7 @@ const FM36 string = `{
 	   "Description":"Event:0 – target left zone,1 – target entered zone",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"175":{
@@ -1808,6 +1823,7 @@ const FM36 string = `{
 	   "Description":"1 – idling start,0 – idling stop",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Idling Stop","1":"Idling Start"},
 	   "FinalConversion":"toUint8"
 	},
 	"249":{
@@ -1822,6 +1838,7 @@ const FM36 string = `{
 	   "Description":"0 – not jammed,1 – jammed",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Not Jammed","1":"Jammed"},
 	   "FinalConversion":"toUint8"
 	},
 	"250":{
@@ -1836,6 +1853,7 @@ const FM36 string = `{
 	   "Description":"1 – trip start,0 – trip stop",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Trip Stop","1":"Trip Start"},
 	   "FinalConversion":"toUint8"
 	},
 	"251":{
@@ -1850,6 +1868,7 @@ const FM36 string = `{
 	   "Description":"1 – iButton connected",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"1":"iButton Connected"},
 	   "FinalConversion":"toUint8"
 	},
 	"252":{
@@ -1864,6 +1883,7 @@ const FM36 string = `{
 	   "Description":"1 – authorized iButton connected",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"1":"Authorized iButton Connected"},
 	   "FinalConversion":"toUint8"
 	},
 	"253":{
@@ -1878,6 +1898,7 @@ const FM36 string = `{
 	   "Description":"1 – harsh acceleration,2 – harsh braking,3 - harsh cornering",
 	   "HWSupport":"FM3612, FM36M1",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"1":"Harsh Acceleration","2":"Harsh Braking","3":"Harsh Cornering"},
 	   "FinalConversion":"toUint8"
 	},
 	"254":{
@@ -16,6 +16,7 @@ const FM64 string = `{
 	   "Description":"0 – Ignition Off 1 – Ignition On",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Ignition Off","1":"Ignition On"},
 	   "FinalConversion":"toUint8"
 	},
 	"240":{
@@ -30,6 +31,7 @@ const FM64 string = `{
 	   "Description":"0 – Movement Off 1 – Movement On",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Movement Off","1":"Movement On"},
 	   "FinalConversion":"toUint8"
 	},
 	"22":{
@@ -44,6 +46,7 @@ const FM64 string = `{
 	   "Description":"0 – Home On Stop 1 – Home On Moving 2 – Roaming On Stop 3 – Roaming On Moving 4 – Unknown On Stop 5 – Unknown On Moving",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Home On Stop","1":"Home On Moving","2":"Roaming On Stop","3":"Roaming On Moving","4":"Unknown On Stop","5":"Unknown On Moving"},
 	   "FinalConversion":"toUint8"
 	},
 	"21":{
@@ -72,6 +75,7 @@ const FM64 string = `{
 	   "Description":"Normal mode Deep Sleep",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Normal Mode","1":"Deep Sleep"},
 	   "FinalConversion":"toUint8"
 	},
 	"71":{
@@ -86,6 +90,7 @@ const FM64 string = `{
 	   "Description":"0 - GNSS OFF 1 - GNSS ON, no GPS antena 2 - GNSS ON, without fix 3 - GNSS ON, with fix 4 - GNSS SLEEP 5 - GNSS Overcurrent/protect state",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"GNSS OFF","1":"GNSS ON, No GPS Antena","2":"GNSS ON, Without Fix","3":"GNSS ON, With Fix","4":"GNSS SLEEP","5":"GNSS Overcurrent/Protect State"},
 	   "FinalConversion":"toUint8"
 	},
 	"181":{
@@ -124,7 +129,7 @@ const FM64 string = `{
 	   "Min":"0",
 	   "Max":"30000",
 	   "Multiplier":"0,001",
-	   "Units":"mV",
+	   "Units":"V",
 	   "Description":"Voltage, mV",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
@@ -180,7 +185,7 @@ const FM64 string = `{
 	   "Min":"0",
 	   "Max":"30000",
 	   "Multiplier":"0,001",
-	   "Units":"mV",
+	   "Units":"V",
 	   "Description":"Voltage, mV",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
@@ -380,6 +385,7 @@ const FM64 string = `{
 	   "Description":"Logic: 0 – not present, 1 – present",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Not Present","1":"Present"},
 	   "FinalConversion":"toUint8"
 	},
 	"2":{
@@ -730,6 +736,7 @@ const FM64 string = `{
 	   "Description":"0 - 3G 1 - 2G",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"3G","1":"2G"},
 	   "FinalConversion":"toUint8"
 	},
 	"4":{
@@ -1066,6 +1073,7 @@ const FM64 string = `{
 	   "Description":"0 - Pedal released 1 - Pedal pressed",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"FMS elements",
+	   "Values":{"0":"Pedal Released","1":"Pedal Pressed"},
 	   "FinalConversion":"toUint8"
 	},
 	"80":{
@@ -1094,6 +1102,7 @@ const FM64 string = `{
 	   "Description":"0 - Switched off 1 - Switched on",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"FMS elements",
+	   "Values":{"0":"Switched Off","1":"Switched On"},
 	   "FinalConversion":"toUint8"
 	},
 	"82":{
@@ -1108,6 +1117,7 @@ const FM64 string = `{
 	   "Description":"0 - Pedal released 1 - Pedal pressed",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"FMS elements",
+	   "Values":{"0":"Pedal Released","1":"Pedal Pressed"},
 	   "FinalConversion":"toUint8"
 	},
 	"83":{
@@ -1122,6 +1132,7 @@ const FM64 string = `{
 	   "Description":"0 - Off/disabled 1 - Set 2 - Not available",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"FMS elements",
+	   "Values":{"0":"Off/Disabled","1":"Set","2":"Not Available"},
 	   "FinalConversion":"toUint8"
 	},
 	"84":{
@@ -1430,6 +1441,7 @@ const FM64 string = `{
 	   "Description":"0 - Diagnostics is not supported 1 - Diagnostics is supported 2 - Reserved 3 - Do not care",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"FMS elements",
+	   "Values":{"0":"Diagnostics Is Not Supported","1":"Diagnostics Is Supported","2":"Reserved","3":"Do Not Care"},
 	   "FinalConversion":"toUint8"
 	},
 	"111":{
@@ -1444,6 +1456,7 @@ const FM64 string = `{
 		"Description":"0 – On request mode is Not supported; 1– On request mode is Supported; 2 – reserved; 3 – Not available;",
 		"HWSupport":"FMB640",
 		"Parametr Group":"FMS elements",
+		"Values":{"0":"On Request Mode Is Not Supported","1":"On Request Mode Is Supported","2":"Reserved","3":"Not Available"},
 		"FinalConversion":"toUint8"
 	 },
 	"113":{
@@ -1598,6 +1611,7 @@ const FM64 string = `{
 	   "Description":"0 - No PTO drive is engaged 1 - At least one PTO drive is engaged 2 - Error 3 - Not available",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"FMS elements",
+	   "Values":{"0":"No PTO Drive Is Engaged","1":"At Least One PTO Drive Is Engaged","2":"Error","3":"Not Available"},
 	   "FinalConversion":"toUint8"
 	},
 	"138":{
@@ -1752,6 +1766,7 @@ const FM64 string = `{
 	   "Description":"Min – 0, Max – 16128 Door status is represented as bitmask converted to decimal value. Possible values: 0 – all doors closed,0x100 (256) – front left door is opened,0x200 (512) – front right door is opened,0x400 (1024) – rear left door is opened,0x800 (2048) – rear right door is opened,0x1000 (4096) – hood is opened,0x2000 (8192) – trunk is opened,0x3F00 (16128) – all doors are opened, or combinations of values",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"CAN adapters elements",
+	   "Bits":{"8":"Front left door open","9":"Front right door open","10":"Rear left door open","11":"Rear right door open","12":"Hood open","13":"Trunk open"},
 	   "FinalConversion":"toUint16"
 	},
 	"12":{
@@ -1990,6 +2005,7 @@ const FM64 string = `{
 	   "Description":"Control state flags",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"CAN adapters elements",
+	   "Bits":{"0":"STOP","1":"Oil pressure / level","2":"Coolant liquid temperature / level","3":"Handbrake system","4":"Battery charging","5":"AIRBAG","8":"CHECK ENGINE","9":"Lights failure","10":"Low tire pressure","11":"Wear of brake pads","12":"Warning","13":"ABS","14":"Low Fuel","16":"ESP","17":"Glow plug indicator","18":"FAP","19":"Electronics pressure control","20":"Parking lights","21":"Dipped headlights","22":"Full beam headlights","30":"Passenger's seat belt","31":"Driver's seat belt"},
 	   "FinalConversion":"toUint32"
 	},
 	"39":{
@@ -2004,6 +2020,7 @@ const FM64 string = `{
 	   "Description":"Agricultural machinery flags",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"CAN adapters elements",
+	   "Bits":{"0":"Mowing","1":"Grain release from hopper","2":"First front hydraulic turned on","3":"Rear Power Take-Off turned on","8":"Excessive play under the threshing drum","9":"Grain tank is open","10":"100% of Grain tank","11":"70% of Grain tank","12":"Drain filter in hydraulic system of drive cylinders is plugged","13":"Pressure filter of drive cylinders hydraulic system is plugged","14":"Alarm oil level in oil tank","15":"Pressure filter of brakes hydraulic system is plugged","16":"Oil filter of engine is plugged","17":"Fuel filter is plugged","18":"Air filter is plugged","19":"Alarm oil temperature in hydraulic system of chasis","20":"Alarm oil temperature in hydraulic system of drive cylinders","21":"Alarm oil pressure in engine","22":"Alarm coolant level","23":"Overflow chamber of hydraulic unit","24":"Unloader drive is ON","25":"No operator","26":"Straw walker is plugged","27":"Water in fuel","28":"Cleaning fan RPM","29":"Trashing drum RPM","33":"Low water level in the tank","34":"First rear hydraulic turned on","35":"Standalone engine working","36":"Right joystick moved right","37":"Right joystick moved left","38":"Right joystick moved front","39":"Right joystick moved back","40":"Brushes turned on","41":"Water supply turned on","42":"Vacuum cleaner","43":"Unloading from the hopper","44":"High Pressure washer (Karcher)","45":"Salt (sand) disperser ON","46":"Low salt (sand) level","48":"Second front hydraulic turned on","49":"Third front hydraulic turned on","50":"Fourth front hydraulic turned on","51":"Second rear hydraulic turned on","52":"Third rear hydraulic turned on","53":"Fourth rear hydraulic turned on","54":"Front three-point Hitch turned on","55":"Rear three-point Hitch turned on","56":"Left joystick moved right","57":"Left joystick moved left","58":"Left joystick moved front","59":"Left joystick moved back","60":"Front Power Take-Off turned on"},
 	   "FinalConversion":"to[]byte"
 	},
 	"40":{
@@ -2116,6 +2133,7 @@ const FM64 string = `{
 	   "Description":"Security State Flag",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"CAN adapters elements",
+	   "Bits":{"0":"CAN1 connected","1":"CAN1 required","2":"CAN2 connected","3":"CAN2 required","4":"CAN3 connected","5":"CAN3 required","6":"CAN4 connected","7":"CAN4 required","21":"Operate button pressed","22":"Immobilizer in service mode","23":"Immobilizer key sequence","24":"Key in ignition lock","25":"Ignition on","26":"Dynamic ignition on","27":"Webasto","29":"Car closed by factory remote control","30":"Factory alarm actuated","31":"Factory alarm emulated by module","32":"Parking activated","36":"Handbrake actuated","37":"Footbrake actuated","38":"Engine working","39":"Reverse on","40":"Front left door open","41":"Front right door open","42":"Rear left door open","43":"Rear right door open","44":"Hood open","45":"Trunk open","55":"CAN module sleep mode"},
 	   "FinalConversion":"to[]byte"
 	},
 	"141":{
@@ -2186,6 +2204,7 @@ const FM64 string = `{
 	   "Description":"0 - Engine not on CNG; 1 - Engine on CNG.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"CAN adapters elements",
+	   "Values":{"0":"Engine Not On CNG","1":"Engine On CNG"},
 	   "FinalConversion":"toUint8"
 	},
 	"227":{
@@ -2228,6 +2247,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"156":{
@@ -2242,6 +2262,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"157":{
@@ -2256,6 +2277,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"158":{
@@ -2270,6 +2292,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"159":{
@@ -2284,6 +2307,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"160":{
@@ -2298,6 +2322,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"161":{
@@ -2312,6 +2337,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"162":{
@@ -2326,6 +2352,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"163":{
@@ -2340,6 +2367,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"164":{
@@ -2354,6 +2382,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"165":{
@@ -2368,6 +2397,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"166":{
@@ -2382,6 +2412,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"167":{
@@ -2396,6 +2427,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"168":{
@@ -2410,6 +2442,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"169":{
@@ -2424,6 +2457,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"170":{
@@ -2438,6 +2472,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"171":{
@@ -2452,6 +2487,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"172":{
@@ -2466,6 +2502,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"173":{
@@ -2480,6 +2517,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"174":{
@@ -2494,6 +2532,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"327":{
@@ -2508,6 +2547,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"328":{
@@ -2522,6 +2562,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"329":{
@@ -2536,6 +2577,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"330":{
@@ -2550,6 +2592,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"331":{
@@ -2564,6 +2607,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"332":{
@@ -2578,6 +2622,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"333":{
@@ -2592,6 +2637,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"334":{
@@ -2606,6 +2652,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"335":{
@@ -2620,6 +2667,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"336":{
@@ -2634,6 +2682,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"337":{
@@ -2648,6 +2697,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"338":{
@@ -2662,6 +2712,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"339":{
@@ -2676,6 +2727,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"340":{
@@ -2690,6 +2742,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"341":{
@@ -2704,6 +2757,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"342":{
@@ -2718,6 +2772,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"343":{
@@ -2732,6 +2787,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"344":{
@@ -2746,6 +2802,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"345":{
@@ -2760,6 +2817,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"346":{
@@ -2774,6 +2832,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"347":{
@@ -2788,6 +2847,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"348":{
@@ -2802,6 +2862,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"349":{
@@ -2816,6 +2877,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"350":{
@@ -2830,6 +2892,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"351":{
@@ -2844,6 +2907,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"352":{
@@ -2858,6 +2922,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"353":{
@@ -2872,6 +2937,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"354":{
@@ -2886,6 +2952,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"355":{
@@ -2900,6 +2967,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"356":{
@@ -2914,6 +2982,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"175":{
@@ -2928,6 +2997,7 @@ const FM64 string = `{
 	   "Description":"0 – target left zone 1 – target entered zone",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"250":{
@@ -2942,6 +3012,7 @@ const FM64 string = `{
 	   "Description":"1 – trip start; 0 – trip stop.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Permanent I/O elements",
+	   "Values":{"0":"Trip Stop","1":"Trip Start"},
 	   "FinalConversion":"toUint8"
 	},
 	"255":{
@@ -2970,6 +3041,7 @@ const FM64 string = `{
 	   "Description":"0 – moving, 1 – idling",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Moving","1":"Idling"},
 	   "FinalConversion":"toUint8"
 	},
 	"253":{
@@ -2984,6 +3056,7 @@ const FM64 string = `{
 	   "Description":"1 – harsh acceleration, 2 – harsh braking, 3 – harsh cornering",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"1":"Harsh Acceleration","2":"Harsh Braking","3":"Harsh Cornering"},
 	   "FinalConversion":"toUint8"
 	},
 	"246":{
@@ -2998,6 +3071,7 @@ const FM64 string = `{
 	   "Description":"0 – steady,1 – towing",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Steady","1":"Towing"},
 	   "FinalConversion":"toUint8"
 	},
 	"248":{
@@ -3026,6 +3100,7 @@ const FM64 string = `{
 	   "Description":"1 – Crash detected 2 – limited crash trace (device not calibrated) 3 - limited crash trace (device is calibrated) 4 - full crash trace (device not calibrated) 5 - full crash trace (device is calibrated)",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"1":"Crash Detected","2":"Limited Crash Trace (Device Not Calibrated)","3":"Limited Crash Trace (Device Is Calibrated)","4":"Full Crash Trace (Device Not Calibrated)","5":"Full Crash Trace (Device Is Calibrated)"},
 	   "FinalConversion":"toUint8"
 	},
 	"251":{
@@ -3040,6 +3115,7 @@ const FM64 string = `{
 	   "Description":"0 – iButton not connected 1 – iButton connected (Immobilizer) 2 – iButton connected (Authorized Driving)",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"iButton Not Connected","1":"iButton Connected (Immobilizer)","2":"iButton Connected (Authorized Driving)"},
 	   "FinalConversion":"toUint8"
 	},
 	"254":{
@@ -3049,7 +3125,7 @@ const FM64 string = `{
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"255",
-	   "Multiplier":"acc and braking: 0.01",
+	   "Multiplier":"0.01",
 	   "Units":"G or rad",
 	   "Description":"Depending on green driving type: if harsh acceleration or braking – g*100 (value 123 -> 1.23g), if harsh cornering – degrees (value in radians)",
 	   "HWSupport":"FMB640",
@@ -3068,6 +3144,7 @@ const FM64 string = `{
 	   "Description":"1 – jamming start 0 – jamming stop",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Jamming Stop","1":"Jamming Start"},
 	   "FinalConversion":"toUint8"
 	},
 	"252":{
@@ -3096,6 +3173,7 @@ const FM64 string = `{
 	   "Description":"0 - Data limit hit in home; 1 - Data limit hit in roaming.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Eventual I/O elements",
+	   "Values":{"0":"Data Limit Hit In Home","1":"Data Limit Hit In Roaming"},
 	   "FinalConversion":"toUint8"
 	},
 	"362":{
@@ -3171,7 +3249,7 @@ const FM64 string = `{
 	"145":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 00",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3185,7 +3263,7 @@ const FM64 string = `{
 	"146":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 01",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3199,7 +3277,7 @@ const FM64 string = `{
 	"147":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 02",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3213,7 +3291,7 @@ const FM64 string = `{
 	"148":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 03",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3227,7 +3305,7 @@ const FM64 string = `{
 	"149":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 04",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3241,7 +3319,7 @@ const FM64 string = `{
 	"150":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 05",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3255,7 +3333,7 @@ const FM64 string = `{
 	"151":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 06",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3269,7 +3347,7 @@ const FM64 string = `{
 	"152":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 07",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3283,7 +3361,7 @@ const FM64 string = `{
 	"153":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 08",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3297,7 +3375,7 @@ const FM64 string = `{
 	"154":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 09",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3311,7 +3389,7 @@ const FM64 string = `{
 	"380":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 10",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3325,7 +3403,7 @@ const FM64 string = `{
 	"381":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 11",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3339,7 +3417,7 @@ const FM64 string = `{
 	"382":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 12",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3353,7 +3431,7 @@ const FM64 string = `{
 	"383":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 13",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3367,7 +3445,7 @@ const FM64 string = `{
 	"384":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 14",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3381,7 +3459,7 @@ const FM64 string = `{
 	"385":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 15",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3395,7 +3473,7 @@ const FM64 string = `{
 	"386":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 16",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3409,7 +3487,7 @@ const FM64 string = `{
 	"387":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 17",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3423,7 +3501,7 @@ const FM64 string = `{
 	"388":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 18",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3437,7 +3515,7 @@ const FM64 string = `{
 	"389":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 19",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3451,7 +3529,7 @@ const FM64 string = `{
 	"10298":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 20",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3465,7 +3543,7 @@ const FM64 string = `{
 	"10299":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 21",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3479,7 +3557,7 @@ const FM64 string = `{
 	"10300":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 22",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3493,7 +3571,7 @@ const FM64 string = `{
 	"10301":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 23",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3507,7 +3585,7 @@ const FM64 string = `{
 	"10302":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 24",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3521,7 +3599,7 @@ const FM64 string = `{
 	"10303":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 25",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3535,7 +3613,7 @@ const FM64 string = `{
 	"10304":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 26",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3549,7 +3627,7 @@ const FM64 string = `{
 	"10305":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 27",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3563,7 +3641,7 @@ const FM64 string = `{
 	"10306":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 28",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3577,7 +3655,7 @@ const FM64 string = `{
 	"10307":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 29",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3591,7 +3669,7 @@ const FM64 string = `{
 	"10308":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 30",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3605,7 +3683,7 @@ const FM64 string = `{
 	"10309":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 31",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3619,7 +3697,7 @@ const FM64 string = `{
 	"10310":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 32",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3633,7 +3711,7 @@ const FM64 string = `{
 	"10311":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 33",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3647,7 +3725,7 @@ const FM64 string = `{
 	"10312":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 34",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3661,7 +3739,7 @@ const FM64 string = `{
 	"10313":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 35",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3675,7 +3753,7 @@ const FM64 string = `{
 	"10314":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 36",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3689,7 +3767,7 @@ const FM64 string = `{
 	"10315":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 37",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3703,7 +3781,7 @@ const FM64 string = `{
 	"10316":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 38",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3717,7 +3795,7 @@ const FM64 string = `{
 	"10317":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 39",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3731,7 +3809,7 @@ const FM64 string = `{
 	"10318":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 40",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3745,7 +3823,7 @@ const FM64 string = `{
 	"10319":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 41",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3759,7 +3837,7 @@ const FM64 string = `{
 	"10320":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 42",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3773,7 +3851,7 @@ const FM64 string = `{
 	"10321":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 43",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3787,7 +3865,7 @@ const FM64 string = `{
 	"10322":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 44",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3801,7 +3879,7 @@ const FM64 string = `{
 	"10323":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 45",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3815,7 +3893,7 @@ const FM64 string = `{
 	"10324":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 46",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3829,7 +3907,7 @@ const FM64 string = `{
 	"10325":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 47",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3843,7 +3921,7 @@ const FM64 string = `{
 	"10326":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 48",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3857,7 +3935,7 @@ const FM64 string = `{
 	"10327":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 49",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3871,7 +3949,7 @@ const FM64 string = `{
 	"10328":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 50",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3885,7 +3963,7 @@ const FM64 string = `{
 	"10329":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 51",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3899,7 +3977,7 @@ const FM64 string = `{
 	"10330":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 52",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3913,7 +3991,7 @@ const FM64 string = `{
 	"10331":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 53",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3927,7 +4005,7 @@ const FM64 string = `{
 	"10332":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 54",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3941,7 +4019,7 @@ const FM64 string = `{
 	"10333":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 55",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3955,7 +4033,7 @@ const FM64 string = `{
 	"10334":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 56",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3969,7 +4047,7 @@ const FM64 string = `{
 	"10335":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 57",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3983,7 +4061,7 @@ const FM64 string = `{
 	"10336":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 58",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -3997,7 +4075,7 @@ const FM64 string = `{
 	"10337":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 59",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4011,7 +4089,7 @@ const FM64 string = `{
 	"10338":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 60",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4025,7 +4103,7 @@ const FM64 string = `{
 	"10339":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 61",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4039,7 +4117,7 @@ const FM64 string = `{
 	"10340":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 62",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4053,7 +4131,7 @@ const FM64 string = `{
 	"10341":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 63",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4067,7 +4145,7 @@ const FM64 string = `{
 	"10342":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 64",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4081,7 +4159,7 @@ const FM64 string = `{
 	"10343":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 65",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4095,7 +4173,7 @@ const FM64 string = `{
 	"10344":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 66",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4109,7 +4187,7 @@ const FM64 string = `{
 	"10345":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 67",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4123,7 +4201,7 @@ const FM64 string = `{
 	"10346":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 68",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4137,7 +4215,7 @@ const FM64 string = `{
 	"10347":{
 	   "No":"",
 	   "PropertyName":"Manual CAN 69",
-	   "Bytes":"43678",
+	   "Bytes":"Variable",
 	   "Type":"Unsigned",
 	   "Min":"0",
 	   "Max":"0xffffffffffffffff",
@@ -4244,6 +4322,7 @@ const FM64 string = `{
 	   "Description":"0 - Rest; 1 - Driver available 2 - Work; 3 - Drive;  4 - Error; 5 - Not available.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Tachograph data elements",
+	   "Values":{"0":"Rest","1":"Driver Available","2":"Work","3":"Drive","4":"Error","5":"Not Available"},
 	   "FinalConversion":"toUint8"
 	},
 	"185":{
@@ -4258,6 +4337,7 @@ const FM64 string = `{
 	   "Description":"0 - Rest; 1 - Driver available 2 - Work; 3 - Drive; 4 - Error; 5 - Not available.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Tachograph data elements",
+	   "Values":{"0":"Rest","1":"Driver Available","2":"Work","3":"Drive","4":"Error","5":"Not Available"},
 	   "FinalConversion":"toUint8"
 	},
 	"186":{
@@ -4692,6 +4772,7 @@ const FM64 string = `{
 	   "Description":"0 - Pedestrian not in danger zone; 1 - Pedestrian in danger zone.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Pedestrian Not In Danger Zone","1":"Pedestrian In Danger Zone"},
 	   "FinalConversion":"toUint8"
 	},
 	"290":{
@@ -4706,6 +4787,7 @@ const FM64 string = `{
 	   "Description":"0 - No forward collision warning; 1 - Forward collision warning.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"No Forward Collision Warning","1":"Forward Collision Warning"},
 	   "FinalConversion":"toUint8"
 	},
 	"291":{
@@ -4720,6 +4802,7 @@ const FM64 string = `{
 	   "Description":"0 - Day is indicated; 1 - Dusk is indicated; 2 - Night is indicated.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Day Is Indicated","1":"Dusk Is Indicated","2":"Night Is Indicated"},
 	   "FinalConversion":"toUint8"
 	},
 	"292":{
@@ -4734,6 +4817,7 @@ const FM64 string = `{
 	   "Description":"0 - Error; 1 - No error.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Error","1":"No Error"},
 	   "FinalConversion":"toUint8"
 	},
 	"293":{
@@ -4902,6 +4986,7 @@ const FM64 string = `{
 	   "Description":"0 - Off; 1 - On.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Off","1":"On"},
 	   "FinalConversion":"toUint8"
 	},
 	"305":{
@@ -4944,6 +5029,7 @@ const FM64 string = `{
 	   "Description":"0 - No tamper alert; 1 - Tamper alert.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"No Tamper Alert","1":"Tamper Alert"},
 	   "FinalConversion":"toUint8"
 	},
 	"308":{
@@ -4972,6 +5058,7 @@ const FM64 string = `{
 	   "Description":"0 - Off; 1 - On.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Off","1":"On"},
 	   "FinalConversion":"toUint8"
 	},
 	"310":{
@@ -4986,6 +5073,7 @@ const FM64 string = `{
 	   "Description":"0 - Off; 1 - On.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Off","1":"On"},
 	   "FinalConversion":"toUint8"
 	},
 	"311":{
@@ -5000,6 +5088,7 @@ const FM64 string = `{
 	   "Description":"0 - Off; 1 - On.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Off","1":"On"},
 	   "FinalConversion":"toUint8"
 	},
 	"312":{
@@ -5014,6 +5103,7 @@ const FM64 string = `{
 	   "Description":"0 - Off; 1 - On.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Off","1":"On"},
 	   "FinalConversion":"toUint8"
 	},
 	"313":{
@@ -5028,6 +5118,7 @@ const FM64 string = `{
 	   "Description":"0 - Off; 1 - On.",
 	   "HWSupport":"FMB640",
 	   "Parametr Group":"Mobileye elements",
+	   "Values":{"0":"Off","1":"On"},
 	   "FinalConversion":"toUint8"
 	},
 	"314":{
@@ -114,6 +114,7 @@ const FMBXY string = `{
        "Description":"Door status value: Min – 0, Max – 16128 Door status is represented as bitmask converted to decimal value. Possible values: 0 – all doors closed, 0x100 (256) – front left door is opened, 0x200 (512) – front right door is opened, 0x400 (1024) – rear left door is opened, 0x800 (2048) – rear right door is opened, 0x1000 (4096) – hood is opened, 0x2000 (8192) – trunk is opened, 0x3F00 (16128) – all doors are opened, or combinations of values",
        "HWSupport":"FMB100, FMB110, FMB120, FMB122, FMB125",
        "Parametr Group":"LVCAN elements",
+       "Bits":{"8":"Front left door open","9":"Front right door open","10":"Rear left door open","11":"Rear right door open","12":"Hood open","13":"Trunk open"},
        "FinalConversion":"toUint16"
     },
     "100":{
@@ -352,6 +353,7 @@ const FMBXY string = `{
        "Description":"Control state flags",
        "HWSupport":"FMB100, FMB110, FMB120, FMB122, FMB125",
        "Parametr Group":"LVCAN elements",
+       "Bits":{"0":"STOP","1":"Oil pressure / level","2":"Coolant liquid temperature / level","3":"Handbrake system","4":"Battery charging","5":"AIRBAG","8":"CHECK ENGINE","9":"Lights failure","10":"Low tire pressure","11":"Wear of brake pads","12":"Warning","13":"ABS","14":"Low Fuel","16":"ESP","17":"Glow plug indicator","18":"FAP","19":"Electronics pressure control","20":"Parking lights","21":"Dipped headlights","22":"Full beam headlights","30":"Passenger's seat belt","31":"Driver's seat belt"},
        "FinalConversion":"toUint32"
     },
     "124":{
@@ -366,6 +368,7 @@ const FMBXY string = `{
        "Description":"Agricultural machinery flags",
        "HWSupport":"FMB100, FMB110, FMB120, FMB122, FMB125",
        "Parametr Group":"LVCAN elements",
+       "Bits":{"0":"Mowing","1":"Grain release from hopper","2":"First front hydraulic turned on","3":"Rear Power Take-Off turned on","8":"Excessive play under the threshing drum","9":"Grain tank is open","10":"100% of Grain tank","11":"70% of Grain tank","12":"Drain filter in hydraulic system of drive cylinders is plugged","13":"Pressure filter of drive cylinders hydraulic system is plugged","14":"Alarm oil level in oil tank","15":"Pressure filter of brakes hydraulic system is plugged","16":"Oil filter of engine is plugged","17":"Fuel filter is plugged","18":"Air filter is plugged","19":"Alarm oil temperature in hydraulic system of chasis","20":"Alarm oil temperature in hydraulic system of drive cylinders","21":"Alarm oil pressure in engine","22":"Alarm coolant level","23":"Overflow chamber of hydraulic unit","24":"Unloader drive is ON","25":"No operator","26":"Straw walker is plugged","27":"Water in fuel","28":"Cleaning fan RPM","29":"Trashing drum RPM","33":"Low water level in the tank","34":"First rear hydraulic turned on","35":"Standalone engine working","36":"Right joystick moved right","37":"Right joystick moved left","38":"Right joystick moved front","39":"Right joystick moved back","40":"Brushes turned on","41":"Water supply turned on","42":"Vacuum cleaner","43":"Unloading from the hopper","44":"High Pressure washer (Karcher)","45":"Salt (sand) disperser ON","46":"Low salt (sand) level","48":"Second front hydraulic turned on","49":"Third front hydraulic turned on","50":"Fourth front hydraulic turned on","51":"Second rear hydraulic turned on","52":"Third rear hydraulic turned on","53":"Fourth rear hydraulic turned on","54":"Front three-point Hitch turned on","55":"Rear three-point Hitch turned on","56":"Left joystick moved right","57":"Left joystick moved left","58":"Left joystick moved front","59":"Left joystick moved back","60":"Front Power Take-Off turned on"},
        "FinalConversion":"to[]byte"
     },
     "125":{
@@ -478,6 +481,7 @@ const FMBXY string = `{
        "Description":"Security State Flag",
        "HWSupport":"FMB100, FMB110, FMB120, FMB122, FMB125",
        "Parametr Group":"LVCAN elements",
+       "Bits":{"0":"CAN1 connected","1":"CAN1 required","2":"CAN2 connected","3":"CAN2 required","4":"CAN3 connected","5":"CAN3 required","6":"CAN4 connected","7":"CAN4 required","21":"Operate button pressed","22":"Immobilizer in service mode","23":"Immobilizer key sequence","24":"Key in ignition lock","25":"Ignition on","26":"Dynamic ignition on","27":"Webasto","29":"Car closed by factory remote control","30":"Factory alarm actuated","31":"Factory alarm emulated by module","32":"Parking activated","36":"Handbrake actuated","37":"Footbrake actuated","38":"Engine working","39":"Reverse on","40":"Front left door open","41":"Front right door open","42":"Rear left door open","43":"Rear right door open","44":"Hood open","45":"Trunk open","55":"CAN module sleep mode"},
        "FinalConversion":"to[]byte"
     },
     "133":{
@@ -1178,6 +1182,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "156":{
@@ -1192,6 +1197,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "157":{
@@ -1206,6 +1212,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "158":{
@@ -1220,6 +1227,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "159":{
@@ -1234,6 +1242,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "61":{
@@ -1248,6 +1257,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "62":{
@@ -1262,6 +1272,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "63":{
@@ -1276,6 +1287,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "64":{
@@ -1290,6 +1302,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "65":{
@@ -1304,6 +1317,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "70":{
@@ -1318,6 +1332,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "88":{
@@ -1332,6 +1347,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "91":{
@@ -1346,6 +1362,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "92":{
@@ -1360,6 +1377,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "93":{
@@ -1374,6 +1392,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "94":{
@@ -1388,6 +1407,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "95":{
@@ -1402,6 +1422,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "96":{
@@ -1416,6 +1437,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "97":{
@@ -1430,6 +1452,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "98":{
@@ -1444,6 +1467,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "99":{
@@ -1458,6 +1482,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "153":{
@@ -1472,6 +1497,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "154":{
@@ -1486,6 +1512,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "190":{
@@ -1500,6 +1527,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "191":{
@@ -1514,6 +1542,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "192":{
@@ -1528,6 +1557,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "193":{
@@ -1542,6 +1572,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "194":{
@@ -1556,6 +1587,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "195":{
@@ -1570,6 +1602,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "196":{
@@ -1584,6 +1617,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "197":{
@@ -1598,6 +1632,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "198":{
@@ -1612,6 +1647,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "208":{
@@ -1626,6 +1662,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "209":{
@@ -1640,6 +1677,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "216":{
@@ -1654,6 +1692,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "217":{
@@ -1668,6 +1707,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "218":{
@@ -1682,6 +1722,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "219":{
@@ -1696,6 +1737,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "220":{
@@ -1710,6 +1752,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "221":{
@@ -1724,6 +1767,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "222":{
@@ -1738,6 +1782,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "223":{
@@ -1752,6 +1797,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "224":{
@@ -1766,6 +1812,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "225":{
@@ -1780,6 +1827,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "226":{
@@ -1794,6 +1842,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "227":{
@@ -1808,6 +1857,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "228":{
@@ -1822,6 +1872,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "229":{
@@ -1836,6 +1887,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "230":{
@@ -1850,6 +1902,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "231":{
@@ -1864,6 +1917,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone 2 – over speeding end 3 – over speeding start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone","2":"Over Speeding End","3":"Over Speeding Start"},
        "FinalConversion":"toUint8"
     },
     "175":{
@@ -1878,6 +1932,7 @@ const FMBXY string = `{
        "Description":"0 – target left zone 1 – target entered zone",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Target Left Zone","1":"Target Entered Zone"},
        "FinalConversion":"toUint8"
     },
     "250":{
@@ -1892,6 +1947,7 @@ const FMBXY string = `{
        "Description":"1 – trip start, 0 – trip stop. From 01.00.24 fw version available with BT app new values: 2 – Business Status; 3 – Private Status; 4-9 – Custom Statuses",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Trip Stop","1":"Trip Start","2":"Business Status","3":"Private Status","4":"Custom Status","5":"Custom Status","6":"Custom Status","7":"Custom Status","8":"Custom Status","9":"Custom Status"},
        "FinalConversion":"toUint8"
     },
     "255":{
@@ -1920,6 +1976,7 @@ const FMBXY string = `{
        "Description":"0 - moving 1 - idling",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Moving","1":"Idling"},
        "FinalConversion":"toUint8"
     },
     "253":{
@@ -1934,6 +1991,7 @@ const FMBXY string = `{
        "Description":"1 – harsh acceleration 2 – harsh braking 3 – harsh cornering",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"1":"Harsh Acceleration","2":"Harsh Braking","3":"Harsh Cornering"},
        "FinalConversion":"toUint8"
     },
     "246":{
@@ -1948,6 +2006,7 @@ const FMBXY string = `{
        "Description":"0 – steady 1 – towing",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Steady","1":"Towing"},
        "FinalConversion":"toUint8"
     },
     "252":{
@@ -1962,6 +2021,7 @@ const FMBXY string = `{
        "Description":"0 – battery present 1 – battery unpluged",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Battery Present","1":"Battery Unplugged"},
        "FinalConversion":"toUint8"
     },
     "247":{
@@ -1976,6 +2036,7 @@ const FMBXY string = `{
        "Description":"1 – crash 2 – limited crash trace (device not calibrated) 3 - limited crash trace (device is calibrated) 4 - full crash trace (device not calibrated) 5 - full crash trace (device is calibrated)",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"1":"Crash","2":"Limited Crash Trace (Device Not Calibrated)","3":"Limited Crash Trace (Device Is Calibrated)","4":"Full Crash Trace (Device Not Calibrated)","5":"Full Crash Trace (Device Is Calibrated)"},
        "FinalConversion":"toUint8"
     },
     "248":{
@@ -1990,6 +2051,7 @@ const FMBXY string = `{
        "Description":"0 – iButton not connected 1 – iButton connected (Immobilizer) 2 – iButton connected (Authorized Driving)",
        "HWSupport":"FMB100, FMB110, FMB120, FMB122, FMB125",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"iButton Not Connected","1":"iButton Connected (Immobilizer)","2":"iButton Connected (Authorized Driving)"},
        "FinalConversion":"toUint8"
     },
     "254":{
@@ -1999,7 +2061,7 @@ const FMBXY string = `{
        "Type":"Unsigned",
        "Min":"0",
        "Max":"255",
-       "Multiplier":"cc and braking 0.0",
+       "Multiplier":"0.01",
        "Units":"G or rad",
        "Description":"Depending on green driving type: if harsh acceleration or braking – g*100 (value 123 -> 1.23g), if harsh cornering – degrees (value in radians)",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
@@ -2018,6 +2080,7 @@ const FMBXY string = `{
        "Description":"1 - jamming start 0 - jamming stop",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Jamming Stop","1":"Jamming Start"},
        "FinalConversion":"toUint8"
     },
     "14":{
@@ -2060,6 +2123,7 @@ const FMBXY string = `{
        "Description":"0 – Reserved 1 – Alarm event occurred",
        "HWSupport":"TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"Reserved","1":"Alarm Event Occurred"},
        "FinalConversion":"toUint8"
     },
     "242":{
@@ -2074,6 +2138,7 @@ const FMBXY string = `{
        "Description":"0 – ManDown diactivated 1 – ManDown is acive",
        "HWSupport":"TMT250",
        "Parametr Group":"Eventual I/O elements",
+       "Values":{"0":"ManDown Deactivated","1":"ManDown Is Active"},
        "FinalConversion":"toUint8"
     },
     "245":{
@@ -2128,7 +2193,9 @@ const FMBXY string = `{
        "Units":"-",
        "Description":"0 - Movement Stop 1 - Movement Start",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
-       "Parametr Group":"Permanent I/O elements"
+       "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"Movement Stop","1":"Movement Start"},
+       "FinalConversion":"toUint8"
     },
     "381":{
        "No":"258",
@@ -2155,6 +2222,7 @@ const FMBXY string = `{
        "Description":"0 - Ignition Off 1 - Ignition On",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"Ignition Off","1":"Ignition On"},
        "FinalConversion":"toUint8"
     },
     "240":{
@@ -2169,6 +2237,7 @@ const FMBXY string = `{
        "Description":"0 - Movement Off 1 - Movement On",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"Movement Off","1":"Movement On"},
        "FinalConversion":"toUint8"
     },
     "80":{
@@ -2183,6 +2252,7 @@ const FMBXY string = `{
        "Description":"0 – Home On Stop 1 – Home On Moving 2 – Roaming On Stop 3 – Roaming On Moving 4 – Unknown On Stop 5 – Unknown On Moving",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"Home On Stop","1":"Home On Moving","2":"Roaming On Stop","3":"Roaming On Moving","4":"Unknown On Stop","5":"Unknown On Moving"},
        "FinalConversion":"toUint8"
     },
     "21":{
@@ -2211,6 +2281,7 @@ const FMBXY string = `{
        "Description":"0 - No Sleep 1 – GPS Sleep 2 – Deep Sleep 3 – Online Sleep",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"No Sleep","1":"GPS Sleep","2":"Deep Sleep","3":"Online Sleep"},
        "FinalConversion":"toUint8"
     },
     "69":{
@@ -2225,6 +2296,7 @@ const FMBXY string = `{
        "Description":"0 - OFF 1 – ON with fix 2 - ON without fix 3 - In sleep state",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010, TMT250",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"OFF","1":"ON With Fix","2":"ON Without Fix","3":"In Sleep State"},
        "FinalConversion":"toUint8"
     },
     "181":{
@@ -2262,8 +2334,8 @@ const FMBXY string = `{
        "Type":"Unsigned",
        "Min":"0",
        "Max":"65535",
-       "Multiplier":"-",
-       "Units":"mV",
+       "Multiplier":"0.001",
+       "Units":"V",
        "Description":"Voltage mV",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Permanent I/O elements",
@@ -2444,8 +2516,8 @@ const FMBXY string = `{
        "Type":"Unsigned",
        "Min":"0",
        "Max":"32767",
-       "Multiplier":"100",
-       "Units":"l/h,*100",
+       "Multiplier":"0.01",
+       "Units":"l/h",
        "Description":"Average Fuel Use, l/h",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Permanent I/O elements",
@@ -2519,6 +2591,7 @@ const FMBXY string = `{
        "Description":"0 - not present 1 - present",
        "HWSupport":"FMB001, FMB010, FMB100, FMB110, FMB120, FMB122, FMB125, FMB900, FMB920, FMB962, FMB964, FM3001, FM3010",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"Not Present","1":"Present"},
        "FinalConversion":"toBool"
     },
     "2":{
@@ -2897,6 +2970,7 @@ const FMBXY string = `{
        "Description":"0 - charger is not connected 1 - charger is connected",
        "HWSupport":"TMT250",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"Charger Is Not Connected","1":"Charger Is Connected"},
        "FinalConversion":"toUint8"
     },
     "238":{
@@ -3107,6 +3181,7 @@ const FMBXY string = `{
        "Description":"0 - 3G 1 - 2G",
        "HWSupport":"FM3001, FM3010",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"3G","1":"2G"},
        "FinalConversion":"toUint8"
     },
     "8":{
@@ -3163,6 +3238,7 @@ const FMBXY string = `{
        "Description":"0 – Unknown 1 – Forward 2 – Backward",
        "HWSupport":"HW with gyro (LSM6DSL)",
        "Parametr Group":"Permanent I/O elements",
+       "Values":{"0":"Unknown","1":"Forward","2":"Backward"},
        "FinalConversion":"toUint8"
     },
     "256":{
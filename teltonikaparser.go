@@ -5,21 +5,50 @@
 // Package teltonikaparser is an implementation of https://wiki.teltonika.lt/view/Codec Codec08 and Codec08Extended for UDP packets in GO Lang
 // implemented https://wiki.teltonika.lt/view/Codec#Codec_8
 // implemented https://wiki.teltonika.lt/view/Codec#Codec_8_Extended
-package main
+// implemented https://wiki.teltonika.lt/view/Codec#Codec_16
+// implemented https://wiki.teltonika.lt/view/Codec#Codec_15
+package teltonikaparser
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/filipkroca/b2n"
 )
 
+// Transport represents a transport layer which was used to deliver a packet
+type Transport uint8
+
+const (
+	// TransportUDP packets carry IMEI and they are acknowledged by 7 Bytes long response
+	TransportUDP Transport = iota + 1
+	// TransportTCP packets are acknowledged by 4 Bytes long number of accepted data
+	TransportTCP
+)
+
+// String returns name of the transport
+func (t Transport) String() string {
+	switch t {
+	case TransportUDP:
+		return "UDP"
+	case TransportTCP:
+		return "TCP"
+	}
+	return fmt.Sprintf("Transport(%d)", uint8(t))
+}
+
 // Decoded struct represent decoded Teltonika data structure with all AVL data as return from function DecodeUDP
 type Decoded struct {
-	IMEI     string    // IMEI number, if len==15 also validated by checksum
-	CodecID  byte      // 0x08 (codec 8) or 0x8E (codec 8 extended)
-	NoOfData uint8     // Number of Data
-	Data     []AvlData // Slice with avl data
-	Response []byte    // Slice with a response
+	IMEI        string    // IMEI number, if len==15 also validated by checksum
+	CodecID     byte      // 0x08 (codec 8), 0x8E (codec 8 extended), 0x10 (codec 16) or 0x0F (codec 15, only TCP)
+	NoOfData    uint8     // Number of Data
+	Data        []AvlData // Slice with avl data
+	Response    []byte    // Slice with a response
+	Transport   Transport // Transport the packet was decoded from
+	PacketID    uint16    // Packet ID, only UDP
+	AvlPacketID uint8     // AVL packet ID, only UDP
+
+	Codec15 *Codec15Data // Raw data of a peripheral, only codec 15
 }
 
 // AvlData represent one block of data
@@ -35,6 +64,50 @@ type AvlData struct {
 	Speed    uint16    // Speed in km/h
 	EventID  uint16    // Event generated (0 – data generated not on event)
 	Elements []Element // Slice containing parsed IO Elements
+
+	GenerationType GenerationType // Record generation type, GenerationNone for codecs other than 16
+}
+
+// GenerationType represents a condition which generated a Codec 16 record
+type GenerationType uint8
+
+// Generation types of Codec 16 records, values are shifted by one against the wire value
+// so that the zero value means the record does not carry the generation type
+const (
+	GenerationNone       GenerationType = 0
+	GenerationOnExit     GenerationType = 1
+	GenerationOnEntrance GenerationType = 2
+	GenerationOnBoth     GenerationType = 3
+	GenerationReserved   GenerationType = 4
+	GenerationHysteresis GenerationType = 5
+	GenerationOnChange   GenerationType = 6
+	GenerationEventual   GenerationType = 7
+	GenerationPeriodical GenerationType = 8
+)
+
+// String returns name of the generation type
+func (g GenerationType) String() string {
+	switch g {
+	case GenerationNone:
+		return "None"
+	case GenerationOnExit:
+		return "On Exit"
+	case GenerationOnEntrance:
+		return "On Entrance"
+	case GenerationOnBoth:
+		return "On Both"
+	case GenerationReserved:
+		return "Reserved"
+	case GenerationHysteresis:
+		return "Hysteresis"
+	case GenerationOnChange:
+		return "On Change"
+	case GenerationEventual:
+		return "Eventual"
+	case GenerationPeriodical:
+		return "Periodical"
+	}
+	return fmt.Sprintf("GenerationType(%d)", uint8(g))
 }
 
 // Element represent one IO element, before storing in a db do a conversion to IO datatype (1B, 2B, 4B, 8B)
@@ -44,29 +117,37 @@ type Element struct {
 	Value  []byte // Value of the element represented by slice of bytes
 }
 
-// DecodeTCP takes a pointer to a slice of bytes with raw data and return Decoded struct
+// DecodeTCP takes a pointer to a slice of bytes with raw data and return Decoded struct,
+// codec 15 frames are returned with empty Data and filled Codec15
 func DecodeTCP(bs *[]byte) (Decoded, error) {
 	decoded := Decoded{}
 	var err error
 	var nextByte int
 
-	// check for minimum packet size
-	if len(*bs) != 17 && len(*bs) < 45 {
-		return Decoded{}, fmt.Errorf("Minimum packet size is 45 Bytes, got %v", len(*bs))
-	}
-
-	// check for teltonika packet ID
-	if (*bs)[0] != 0x00 || (*bs)[1] != 0x00 {
-		return Decoded{}, fmt.Errorf("Probably not Teltonika packet, trashed")
+	// validate preamble, data field length and CRC, IMEI login packet is decoded by DecodeIMEI
+	dataLen, err := checkTCPFrame(bs)
+	if err != nil {
+		return Decoded{}, err
 	}
 
 	// count start bit for data
 	startByte := 8
+	crcStart := startByte + dataLen
+
+	// codec 15 frame carries raw data of a peripheral instead of AVL data
+	if (*bs)[startByte] == 0x0F {
+		return decodeCodec15(bs)
+	}
+
+	// check for minimum packet size
+	if len(*bs) < 45 {
+		return Decoded{}, decodeError(0, FieldFrame, ErrTooShort, "minimum packet size is 45 Bytes, got %v", len(*bs))
+	}
 
 	// decode Codec ID
 	decoded.CodecID = (*bs)[startByte]
-	if decoded.CodecID != 0x08 && decoded.CodecID != 0x8e {
-		return Decoded{}, fmt.Errorf("Invalid Codec ID, want 0x08 or 0x8E, get %v", decoded.CodecID)
+	if decoded.CodecID != 0x08 && decoded.CodecID != 0x8e && decoded.CodecID != 0x10 {
+		return Decoded{}, decodeError(startByte, FieldCodecID, ErrInvalidCodec, "want 0x08, 0x8E, 0x10 or 0x0F, got %#x", decoded.CodecID)
 	}
 
 	// initialize nextByte counter
@@ -75,137 +156,78 @@ func DecodeTCP(bs *[]byte) (Decoded, error) {
 	// determine no of data in packet
 	decoded.NoOfData, err = b2n.ParseBs2Uint8(bs, nextByte)
 	if err != nil {
-		return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+		return Decoded{}, unexpectedEnd(bs, nextByte, FieldNoOfData, 1)
 	}
 
 	// increment nextByte counter
 	nextByte++
 
-	// make slice for decoded data
-	decoded.Data = make([]AvlData, 0, decoded.NoOfData)
-	// go through data
-	for i := 0; i < int(decoded.NoOfData); i++ {
-
-		decodedData := AvlData{}
-
-		// time record in ms has 8 Bytes
-		decodedData.UtimeMs, err = b2n.ParseBs2Uint64(bs, nextByte)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
-
-		decodedData.Utime = uint64(decodedData.UtimeMs / 1000)
-		nextByte += 8
-
-		// parse priority
-		decodedData.Priority, err = b2n.ParseBs2Uint8(bs, nextByte)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
-		if !(decodedData.Priority <= 2) {
-			return Decoded{}, fmt.Errorf("Invalid Priority value, want priority <= 2, got %v", decodedData.Priority)
-		}
-
-		nextByte++
-
-		// parse and validate GPS
-		decodedData.Lng, err = b2n.ParseBs2Int32TwoComplement(bs, nextByte)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
-		if !(decodedData.Lng > -1800000000 && decodedData.Lng < 1800000000) {
-			return Decoded{}, fmt.Errorf("Invalid Lat value, want lat > -1800000000 AND lat < 1800000000, got %v", decodedData.Lng)
-		}
-		nextByte += 4
-
-		decodedData.Lat, err = b2n.ParseBs2Int32TwoComplement(bs, nextByte)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
-
-		if !(decodedData.Lat > -850000000 && decodedData.Lat < 850000000) {
-			return Decoded{}, fmt.Errorf("Invalid Lat value, want lat > -850000000 AND lat < 850000000, got %v", decodedData.Lat)
-		}
-		nextByte += 4
-
-		// parse Altitude
-		decodedData.Altitude, err = b2n.ParseBs2Int16TwoComplement(bs, nextByte)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
-		if !(decodedData.Altitude > -5000 && decodedData.Altitude < 12000) {
-			return Decoded{}, fmt.Errorf("Invalid Altitude value, want Altitude > -5000 AND Altitude < 12000, got %v", decodedData.Altitude)
-		}
-		nextByte += 2
-
-		// parse Angle
-		decodedData.Angle, err = b2n.ParseBs2Uint16(bs, nextByte)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
-		if decodedData.Angle > 360 {
-			return Decoded{}, fmt.Errorf("Invalid Angle value, want Angle <= 360, got %v", decodedData.Angle)
-		}
-		nextByte += 2
-
-		// parse num. of vissible sattelites VisSat
-		decodedData.VisSat, err = b2n.ParseBs2Uint8(bs, nextByte)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
-		nextByte++
-
-		// parse Speed
-		decodedData.Speed, err = b2n.ParseBs2Uint16(bs, nextByte)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
-		nextByte += 2
+	// decode AVL data
+	decoded.Data, nextByte, err = decodeAvlData(bs, nextByte, decoded.CodecID, decoded.NoOfData)
+	if err != nil {
+		return Decoded{}, err
+	}
 
-		// parse EventID
-		if decoded.CodecID == 0x8e {
-			// if Codec 8 extended is used, Event id has size 2 bytes
-			decodedData.EventID, err = b2n.ParseBs2Uint16(bs, nextByte)
-			if err != nil {
-				return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-			}
+	// AVL data have to end exactly one byte before CRC, the byte holds the second Number of Data
+	if nextByte != crcStart-1 {
+		return Decoded{}, decodeError(4, FieldDataLength, ErrDataLength, "declared %v Bytes of data, parsed %v Bytes", dataLen, nextByte+1-startByte)
+	}
 
-			nextByte += 2
-		} else {
-			x, err := b2n.ParseBs2Uint8(bs, nextByte)
-			if err != nil {
-				return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-			}
-			decodedData.EventID = uint16(x)
-			nextByte++
-		}
+	// check if packet was corretly parsed
+	endNoOfData := (*bs)[nextByte]
+	if decoded.NoOfData != endNoOfData {
+		return Decoded{}, decodeError(nextByte, FieldNoOfData, ErrCountMismatch, "control num. of data on end of parsing, want %#x, got %#x", decoded.NoOfData, endNoOfData)
+	}
 
-		decodedIO, endByte, err := DecodeElements(bs, nextByte, decoded.CodecID)
-		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
-		}
+	// create response packet
+	decoded.Transport = TransportTCP
+	decoded.Response = decoded.BuildResponse(decoded.NoOfData)
 
-		nextByte = endByte
-		decodedData.Elements = decodedIO
+	return decoded, nil
+}
 
-		decoded.Data = append(decoded.Data, decodedData)
+// checkTCPFrame validates four zero bytes preamble, declared data field length and CRC-16/IBM of a TCP frame
+// and returns the length of the data field
+func checkTCPFrame(bs *[]byte) (int, error) {
+	// check for minimum frame size
+	if len(*bs) < 12 {
+		return 0, decodeError(0, FieldFrame, ErrTooShort, "minimum frame size is 12 Bytes, got %v", len(*bs))
+	}
 
+	// check for four zero bytes preamble
+	if (*bs)[0] != 0x00 || (*bs)[1] != 0x00 || (*bs)[2] != 0x00 || (*bs)[3] != 0x00 {
+		return 0, decodeError(0, FieldPreamble, ErrPreamble, "got %#x", (*bs)[0:4])
 	}
 
-	if int(decoded.NoOfData) != len(decoded.Data) {
-		return Decoded{}, fmt.Errorf("Error when counting number of parsed data, want %v, got %v", int(decoded.NoOfData), len(decoded.Data))
+	// data field length, it counts bytes from Codec ID to the second Number of Data
+	dataLen, err := b2n.ParseBs2Uint32(bs, 4)
+	if err != nil {
+		return 0, unexpectedEnd(bs, 4, FieldDataLength, 4)
+	}
+	if int64(dataLen)+12 != int64(len(*bs)) {
+		return 0, decodeError(4, FieldDataLength, ErrDataLength, "declared %v Bytes of data, want frame of %v Bytes, got %v", dataLen, int64(dataLen)+12, len(*bs))
 	}
 
-	// check if packet was corretly parsed
-	endNoOfData := (*bs)[nextByte]
-	if decoded.NoOfData != endNoOfData {
-		return Decoded{}, fmt.Errorf("Unexpected byte representing control num. of data on end of parsing, want %#x, got %#x", decoded.NoOfData, endNoOfData)
+	// validate CRC-16/IBM calculated over the data field, it is stored in the last 4 Bytes
+	crcStart := 8 + int(dataLen)
+	receivedCRC, err := b2n.ParseBs2Uint32(bs, crcStart)
+	if err != nil {
+		return 0, unexpectedEnd(bs, crcStart, FieldCRC, 4)
+	}
+	if calculatedCRC := crc16IBM((*bs)[8:crcStart]); receivedCRC != uint32(calculatedCRC) {
+		return 0, decodeError(crcStart, FieldCRC, ErrCRC, "calculated %#04x, received %#08x", calculatedCRC, receivedCRC)
 	}
 
-	// create response packet
-	decoded.Response = []byte{0x00, 0x05, 0xCA, 0xFE, 0x01, (*bs)[4], decoded.NoOfData}
+	return int(dataLen), nil
+}
 
-	return decoded, nil
+// encodeTCPFrame wraps a data field into a TCP frame with four zero bytes preamble, data field length and CRC-16/IBM
+func encodeTCPFrame(data []byte) []byte {
+	frame := make([]byte, 8, 8+len(data)+4)
+	binary.BigEndian.PutUint32(frame[4:8], uint32(len(data)))
+	frame = append(frame, data...)
+	crc := crc16IBM(data)
+	return append(frame, 0x00, 0x00, byte(crc>>8), byte(crc))
 }
 
 // DecodeUDP takes a pointer to a slice of bytes with raw data and return Decoded struct
@@ -216,30 +238,40 @@ func DecodeUDP(bs *[]byte) (Decoded, error) {
 
 	// check for minimum packet size
 	if len(*bs) < 45 {
-		return Decoded{}, fmt.Errorf("Minimum packet size is 45 Bytes, got %v", len(*bs))
+		return Decoded{}, decodeError(0, FieldFrame, ErrTooShort, "minimum packet size is 45 Bytes, got %v", len(*bs))
 	}
 
 	// check for teltonika packet ID
 	if (*bs)[2] != 0xca || (*bs)[3] != 0xfe {
-		return Decoded{}, fmt.Errorf("Probably not Teltonika packet, trashed")
+		return Decoded{}, decodeError(2, FieldPacketID, ErrNotTeltonika, "want 0xcafe, got %#x", (*bs)[2:4])
+	}
+
+	// parse UDP channel header, it is needed for the response
+	decoded.Transport = TransportUDP
+	decoded.PacketID, err = b2n.ParseBs2Uint16(bs, 2)
+	if err != nil {
+		return Decoded{}, unexpectedEnd(bs, 2, FieldPacketID, 2)
 	}
+	decoded.AvlPacketID = (*bs)[5]
 
 	// determine bit number where start data, it can change because of IMEI length
-	imeiLenX, err := b2n.ParseBs2Uint8(bs, 7)
+	imeiLenX, err := b2n.ParseBs2Uint16(bs, 6)
 	if err != nil {
-		return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+		return Decoded{}, unexpectedEnd(bs, 6, FieldIMEILength, 2)
 	}
 	imeiLen := int(imeiLenX)
 
 	if imeiLen != 15 && imeiLen != 16 {
-		// log.Fatalf("Error when determining IMEI len want 15 or 16, got %v", imeiLen)
-		return Decoded{}, fmt.Errorf("Error when determining IMEI len want 15 or 16, got %v", imeiLen)
+		return Decoded{}, decodeError(6, FieldIMEILength, ErrInvalidIMEI, "want IMEI length 15 or 16, got %v", imeiLen)
 	}
 
 	// decode and validate IMEI
+	if len(*bs) < 8+imeiLen {
+		return Decoded{}, unexpectedEnd(bs, 8, FieldIMEI, imeiLen)
+	}
 	decoded.IMEI, err = b2n.ParseIMEI(bs, 8, imeiLen)
 	if err != nil {
-		return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+		return Decoded{}, decodeError(8, FieldIMEI, ErrInvalidIMEI, "%v", err)
 	}
 
 	// count start bit for data
@@ -247,8 +279,8 @@ func DecodeUDP(bs *[]byte) (Decoded, error) {
 
 	// decode Codec ID
 	decoded.CodecID = (*bs)[startByte]
-	if decoded.CodecID != 0x08 && decoded.CodecID != 0x8e {
-		return Decoded{}, fmt.Errorf("Invalid Codec ID, want 0x08 or 0x8E, get %v", decoded.CodecID)
+	if decoded.CodecID != 0x08 && decoded.CodecID != 0x8e && decoded.CodecID != 0x10 {
+		return Decoded{}, decodeError(startByte, FieldCodecID, ErrInvalidCodec, "want 0x08, 0x8E or 0x10, got %#x", decoded.CodecID)
 	}
 
 	// initialize nextByte counter
@@ -257,23 +289,50 @@ func DecodeUDP(bs *[]byte) (Decoded, error) {
 	// determine no of data in packet
 	decoded.NoOfData, err = b2n.ParseBs2Uint8(bs, nextByte)
 	if err != nil {
-		return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+		return Decoded{}, unexpectedEnd(bs, nextByte, FieldNoOfData, 1)
 	}
 
 	// increment nextByte counter
 	nextByte++
 
+	// decode AVL data
+	decoded.Data, nextByte, err = decodeAvlData(bs, nextByte, decoded.CodecID, decoded.NoOfData)
+	if err != nil {
+		return Decoded{}, err
+	}
+
+	// check if packet was corretly parsed
+	endNoOfData, err := b2n.ParseBs2Uint8(bs, nextByte)
+	if err != nil {
+		return Decoded{}, unexpectedEnd(bs, nextByte, FieldNoOfData, 1)
+	}
+	if decoded.NoOfData != endNoOfData {
+		return Decoded{}, decodeError(nextByte, FieldNoOfData, ErrCountMismatch, "control num. of data on end of parsing, want %#x, got %#x", decoded.NoOfData, endNoOfData)
+	}
+
+	// create response packet
+	decoded.Response = decoded.BuildResponse(decoded.NoOfData)
+
+	return decoded, nil
+}
+
+// decodeAvlData takes a pointer to a byte slice with raw data, start Byte position of the first AVL data, Codec ID
+// and number of data, and returns slice of AvlData and the position of the next Byte after AVL data
+func decodeAvlData(bs *[]byte, start int, codecID byte, noOfData uint8) ([]AvlData, int, error) {
+	var err error
+	nextByte := start
+
 	// make slice for decoded data
-	decoded.Data = make([]AvlData, 0, decoded.NoOfData)
+	data := make([]AvlData, 0, noOfData)
 	// go through data
-	for i := 0; i < int(decoded.NoOfData); i++ {
+	for i := 0; i < int(noOfData); i++ {
 
 		decodedData := AvlData{}
 
 		// time record in ms has 8 Bytes
 		decodedData.UtimeMs, err = b2n.ParseBs2Uint64(bs, nextByte)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldTimestamp, 8), i)
 		}
 
 		decodedData.Utime = uint64(decodedData.UtimeMs / 1000)
@@ -282,10 +341,10 @@ func DecodeUDP(bs *[]byte) (Decoded, error) {
 		// parse priority
 		decodedData.Priority, err = b2n.ParseBs2Uint8(bs, nextByte)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldPriority, 1), i)
 		}
 		if !(decodedData.Priority <= 2) {
-			return Decoded{}, fmt.Errorf("Invalid Priority value, want priority <= 2, got %v", decodedData.Priority)
+			return nil, 0, inRecord(decodeError(nextByte, FieldPriority, ErrInvalidValue, "want priority <= 2, got %v", decodedData.Priority), i)
 		}
 
 		nextByte++
@@ -293,99 +352,111 @@ func DecodeUDP(bs *[]byte) (Decoded, error) {
 		// parse and validate GPS
 		decodedData.Lng, err = b2n.ParseBs2Int32TwoComplement(bs, nextByte)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldLongitude, 4), i)
 		}
 		if !(decodedData.Lng > -1800000000 && decodedData.Lng < 1800000000) {
-			return Decoded{}, fmt.Errorf("Invalid Lat value, want lat > -1800000000 AND lat < 1800000000, got %v", decodedData.Lng)
+			return nil, 0, inRecord(decodeError(nextByte, FieldLongitude, ErrInvalidValue, "want lng > -1800000000 AND lng < 1800000000, got %v", decodedData.Lng), i)
 		}
 		nextByte += 4
 
 		decodedData.Lat, err = b2n.ParseBs2Int32TwoComplement(bs, nextByte)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldLatitude, 4), i)
 		}
 
 		if !(decodedData.Lat > -850000000 && decodedData.Lat < 850000000) {
-			return Decoded{}, fmt.Errorf("Invalid Lat value, want lat > -850000000 AND lat < 850000000, got %v", decodedData.Lat)
+			return nil, 0, inRecord(decodeError(nextByte, FieldLatitude, ErrInvalidValue, "want lat > -850000000 AND lat < 850000000, got %v", decodedData.Lat), i)
 		}
 		nextByte += 4
 
 		// parse Altitude
 		decodedData.Altitude, err = b2n.ParseBs2Int16TwoComplement(bs, nextByte)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldAltitude, 2), i)
 		}
 		if !(decodedData.Altitude > -5000 && decodedData.Altitude < 12000) {
-			return Decoded{}, fmt.Errorf("Invalid Altitude value, want Altitude > -5000 AND Altitude < 12000, got %v", decodedData.Altitude)
+			return nil, 0, inRecord(decodeError(nextByte, FieldAltitude, ErrInvalidValue, "want Altitude > -5000 AND Altitude < 12000, got %v", decodedData.Altitude), i)
 		}
 		nextByte += 2
 
 		// parse Angle
 		decodedData.Angle, err = b2n.ParseBs2Uint16(bs, nextByte)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldAngle, 2), i)
 		}
 		if decodedData.Angle > 360 {
-			return Decoded{}, fmt.Errorf("Invalid Angle value, want Angle <= 360, got %v", decodedData.Angle)
+			return nil, 0, inRecord(decodeError(nextByte, FieldAngle, ErrInvalidValue, "want Angle <= 360, got %v", decodedData.Angle), i)
 		}
 		nextByte += 2
 
 		// parse num. of vissible sattelites VisSat
 		decodedData.VisSat, err = b2n.ParseBs2Uint8(bs, nextByte)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldSatellites, 1), i)
 		}
 		nextByte++
 
 		// parse Speed
 		decodedData.Speed, err = b2n.ParseBs2Uint16(bs, nextByte)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldSpeed, 2), i)
 		}
 		nextByte += 2
 
 		// parse EventID
-		if decoded.CodecID == 0x8e {
-			// if Codec 8 extended is used, Event id has size 2 bytes
+		if codecID == 0x8e || codecID == 0x10 {
+			// if Codec 8 extended or Codec 16 is used, Event id has size 2 bytes
 			decodedData.EventID, err = b2n.ParseBs2Uint16(bs, nextByte)
 			if err != nil {
-				return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+				return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldEventID, 2), i)
 			}
 
 			nextByte += 2
+
+			// Codec 16 carries Generation Type after Event id
+			if codecID == 0x10 {
+				x, err := b2n.ParseBs2Uint8(bs, nextByte)
+				if err != nil {
+					return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldGenerationType, 1), i)
+				}
+				if !(x <= 7) {
+					return nil, 0, inRecord(decodeError(nextByte, FieldGenerationType, ErrInvalidValue, "want Generation Type <= 7, got %v", x), i)
+				}
+				decodedData.GenerationType = GenerationType(x) + 1
+				nextByte++
+			}
 		} else {
 			x, err := b2n.ParseBs2Uint8(bs, nextByte)
 			if err != nil {
-				return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+				return nil, 0, inRecord(unexpectedEnd(bs, nextByte, FieldEventID, 1), i)
 			}
 			decodedData.EventID = uint16(x)
 			nextByte++
 		}
 
-		decodedIO, endByte, err := DecodeElements(bs, nextByte, decoded.CodecID)
+		decodedIO, endByte, err := DecodeElements(bs, nextByte, codecID)
 		if err != nil {
-			return Decoded{}, fmt.Errorf("DecodeUDP error, %v", err)
+			return nil, 0, inRecord(err, i)
 		}
 
 		nextByte = endByte
 		decodedData.Elements = decodedIO
 
-		decoded.Data = append(decoded.Data, decodedData)
+		data = append(data, decodedData)
 
 	}
 
-	if int(decoded.NoOfData) != len(decoded.Data) {
-		return Decoded{}, fmt.Errorf("Error when counting number of parsed data, want %v, got %v", int(decoded.NoOfData), len(decoded.Data))
-	}
+	return data, nextByte, nil
+}
 
-	// check if packet was corretly parsed
-	endNoOfData := (*bs)[nextByte]
-	if decoded.NoOfData != endNoOfData {
-		return Decoded{}, fmt.Errorf("Unexpected byte representing control num. of data on end of parsing, want %#x, got %#x", decoded.NoOfData, endNoOfData)
+// BuildResponse creates an acknowledgement of accepted number of data according to the transport the packet was decoded from,
+// TCP response is 4 Bytes long number of accepted data, UDP response is 0x0005 | Packet ID | 0x01 | AVL packet ID | accepted data
+func (d *Decoded) BuildResponse(accepted uint8) []byte {
+	switch d.Transport {
+	case TransportTCP:
+		return []byte{0x00, 0x00, 0x00, accepted}
+	case TransportUDP:
+		return []byte{0x00, 0x05, byte(d.PacketID >> 8), byte(d.PacketID), 0x01, d.AvlPacketID, accepted}
 	}
-
-	// create response packet
-	decoded.Response = []byte{0x00, 0x05, 0xCA, 0xFE, 0x01, (*bs)[4], decoded.NoOfData}
-
-	return decoded, nil
+	return nil
 }
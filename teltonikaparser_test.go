@@ -2,12 +2,14 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package teltonikaparser
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"log"
+	"reflect"
 	"testing"
 )
 
@@ -36,9 +38,230 @@ func ExampleDecode() {
 
 	// Output:
 	// Decoded packet codec 8:
-	// {IMEI:352094089397464 CodecID:8 NoOfData:4 Data:[{UtimeMs:1528069090050 Utime:1528069090 Priority:1 Lat:491403133 Lng:170206400 Altitude:211 Angle:303 VisSat:19 Speed:50 EventID:66 Elements:[{Length:1 IOID:69 Value:[3]} {Length:1 IOID:240 Value:[1]} {Length:1 IOID:80 Value:[5]} {Length:1 IOID:21 Value:[3]} {Length:1 IOID:239 Value:[1]} {Length:1 IOID:81 Value:[0]} {Length:1 IOID:82 Value:[0]} {Length:1 IOID:89 Value:[0]} {Length:1 IOID:190 Value:[0]} {Length:1 IOID:193 Value:[0]} {Length:2 IOID:181 Value:[0 8]} {Length:2 IOID:182 Value:[0 6]} {Length:2 IOID:66 Value:[111 216]} {Length:2 IOID:205 Value:[61 30]} {Length:2 IOID:206 Value:[96 90]} {Length:2 IOID:84 Value:[0 0]} {Length:2 IOID:85 Value:[0 0]} {Length:2 IOID:115 Value:[0 0]} {Length:2 IOID:90 Value:[0 0]} {Length:2 IOID:192 Value:[0 0]} {Length:4 IOID:199 Value:[0 0 0 13]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 45 51 198]} {Length:4 IOID:83 Value:[0 0 0 0]} {Length:4 IOID:87 Value:[0 0 0 0]} {Length:4 IOID:100 Value:[0 0 0 247]} {Length:4 IOID:191 Value:[0 0 0 0]}]} {UtimeMs:1528069089000 Utime:1528069089 Priority:1 Lat:491401583 Lng:170209400 Altitude:212 Angle:305 VisSat:19 Speed:49 EventID:66 Elements:[{Length:1 IOID:69 Value:[3]} {Length:1 IOID:240 Value:[1]} {Length:1 IOID:80 Value:[5]} {Length:1 IOID:21 Value:[3]} {Length:1 IOID:239 Value:[1]} {Length:1 IOID:81 Value:[0]} {Length:1 IOID:82 Value:[0]} {Length:1 IOID:89 Value:[0]} {Length:1 IOID:190 Value:[0]} {Length:1 IOID:193 Value:[0]} {Length:2 IOID:181 Value:[0 8]} {Length:2 IOID:182 Value:[0 5]} {Length:2 IOID:66 Value:[111 203]} {Length:2 IOID:205 Value:[61 30]} {Length:2 IOID:206 Value:[96 90]} {Length:2 IOID:84 Value:[0 0]} {Length:2 IOID:85 Value:[0 0]} {Length:2 IOID:115 Value:[0 0]} {Length:2 IOID:90 Value:[0 0]} {Length:2 IOID:192 Value:[0 0]} {Length:4 IOID:199 Value:[0 0 0 14]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 45 51 185]} {Length:4 IOID:83 Value:[0 0 0 0]} {Length:4 IOID:87 Value:[0 0 0 0]} {Length:4 IOID:100 Value:[0 0 0 247]} {Length:4 IOID:191 Value:[0 0 0 0]}]} {UtimeMs:1528069087000 Utime:1528069087 Priority:1 Lat:491400783 Lng:170210966 Altitude:213 Angle:308 VisSat:19 Speed:51 EventID:66 Elements:[{Length:1 IOID:69 Value:[3]} {Length:1 IOID:240 Value:[1]} {Length:1 IOID:80 Value:[5]} {Length:1 IOID:21 Value:[3]} {Length:1 IOID:239 Value:[1]} {Length:1 IOID:81 Value:[0]} {Length:1 IOID:82 Value:[0]} {Length:1 IOID:89 Value:[0]} {Length:1 IOID:190 Value:[0]} {Length:1 IOID:193 Value:[0]} {Length:2 IOID:181 Value:[0 8]} {Length:2 IOID:182 Value:[0 5]} {Length:2 IOID:66 Value:[112 43]} {Length:2 IOID:205 Value:[61 30]} {Length:2 IOID:206 Value:[96 90]} {Length:2 IOID:84 Value:[0 0]} {Length:2 IOID:85 Value:[0 0]} {Length:2 IOID:115 Value:[0 0]} {Length:2 IOID:90 Value:[0 0]} {Length:2 IOID:192 Value:[0 0]} {Length:4 IOID:199 Value:[0 0 0 30]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 45 51 170]} {Length:4 IOID:83 Value:[0 0 0 0]} {Length:4 IOID:87 Value:[0 0 0 0]} {Length:4 IOID:100 Value:[0 0 0 247]} {Length:4 IOID:191 Value:[0 0 0 0]}]} {UtimeMs:1528069070050 Utime:1528069070 Priority:1 Lat:491385900 Lng:170252500 Altitude:220 Angle:291 VisSat:18 Speed:88 EventID:66 Elements:[{Length:1 IOID:69 Value:[3]} {Length:1 IOID:240 Value:[1]} {Length:1 IOID:80 Value:[5]} {Length:1 IOID:21 Value:[3]} {Length:1 IOID:239 Value:[1]} {Length:1 IOID:81 Value:[0]} {Length:1 IOID:82 Value:[0]} {Length:1 IOID:89 Value:[0]} {Length:1 IOID:190 Value:[0]} {Length:1 IOID:193 Value:[0]} {Length:2 IOID:181 Value:[0 9]} {Length:2 IOID:182 Value:[0 5]} {Length:2 IOID:66 Value:[112 49]} {Length:2 IOID:205 Value:[121 216]} {Length:2 IOID:206 Value:[96 90]} {Length:2 IOID:84 Value:[0 0]} {Length:2 IOID:85 Value:[0 0]} {Length:2 IOID:115 Value:[0 0]} {Length:2 IOID:90 Value:[0 0]} {Length:2 IOID:192 Value:[0 0]} {Length:4 IOID:199 Value:[0 0 0 25]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 45 50 80]} {Length:4 IOID:83 Value:[0 0 0 0]} {Length:4 IOID:87 Value:[0 0 0 0]} {Length:4 IOID:100 Value:[0 0 0 247]} {Length:4 IOID:191 Value:[0 0 0 0]}]}] Response:[0 5 202 254 1 1 4]}
+	// {IMEI:352094089397464 CodecID:8 NoOfData:4 Data:[{UtimeMs:1528069090050 Utime:1528069090 Priority:1 Lat:491403133 Lng:170206400 Altitude:211 Angle:303 VisSat:19 Speed:50 EventID:66 Elements:[{Length:1 IOID:69 Value:[3]} {Length:1 IOID:240 Value:[1]} {Length:1 IOID:80 Value:[5]} {Length:1 IOID:21 Value:[3]} {Length:1 IOID:239 Value:[1]} {Length:1 IOID:81 Value:[0]} {Length:1 IOID:82 Value:[0]} {Length:1 IOID:89 Value:[0]} {Length:1 IOID:190 Value:[0]} {Length:1 IOID:193 Value:[0]} {Length:2 IOID:181 Value:[0 8]} {Length:2 IOID:182 Value:[0 6]} {Length:2 IOID:66 Value:[111 216]} {Length:2 IOID:205 Value:[61 30]} {Length:2 IOID:206 Value:[96 90]} {Length:2 IOID:84 Value:[0 0]} {Length:2 IOID:85 Value:[0 0]} {Length:2 IOID:115 Value:[0 0]} {Length:2 IOID:90 Value:[0 0]} {Length:2 IOID:192 Value:[0 0]} {Length:4 IOID:199 Value:[0 0 0 13]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 45 51 198]} {Length:4 IOID:83 Value:[0 0 0 0]} {Length:4 IOID:87 Value:[0 0 0 0]} {Length:4 IOID:100 Value:[0 0 0 247]} {Length:4 IOID:191 Value:[0 0 0 0]}] GenerationType:None} {UtimeMs:1528069089000 Utime:1528069089 Priority:1 Lat:491401583 Lng:170209400 Altitude:212 Angle:305 VisSat:19 Speed:49 EventID:66 Elements:[{Length:1 IOID:69 Value:[3]} {Length:1 IOID:240 Value:[1]} {Length:1 IOID:80 Value:[5]} {Length:1 IOID:21 Value:[3]} {Length:1 IOID:239 Value:[1]} {Length:1 IOID:81 Value:[0]} {Length:1 IOID:82 Value:[0]} {Length:1 IOID:89 Value:[0]} {Length:1 IOID:190 Value:[0]} {Length:1 IOID:193 Value:[0]} {Length:2 IOID:181 Value:[0 8]} {Length:2 IOID:182 Value:[0 5]} {Length:2 IOID:66 Value:[111 203]} {Length:2 IOID:205 Value:[61 30]} {Length:2 IOID:206 Value:[96 90]} {Length:2 IOID:84 Value:[0 0]} {Length:2 IOID:85 Value:[0 0]} {Length:2 IOID:115 Value:[0 0]} {Length:2 IOID:90 Value:[0 0]} {Length:2 IOID:192 Value:[0 0]} {Length:4 IOID:199 Value:[0 0 0 14]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 45 51 185]} {Length:4 IOID:83 Value:[0 0 0 0]} {Length:4 IOID:87 Value:[0 0 0 0]} {Length:4 IOID:100 Value:[0 0 0 247]} {Length:4 IOID:191 Value:[0 0 0 0]}] GenerationType:None} {UtimeMs:1528069087000 Utime:1528069087 Priority:1 Lat:491400783 Lng:170210966 Altitude:213 Angle:308 VisSat:19 Speed:51 EventID:66 Elements:[{Length:1 IOID:69 Value:[3]} {Length:1 IOID:240 Value:[1]} {Length:1 IOID:80 Value:[5]} {Length:1 IOID:21 Value:[3]} {Length:1 IOID:239 Value:[1]} {Length:1 IOID:81 Value:[0]} {Length:1 IOID:82 Value:[0]} {Length:1 IOID:89 Value:[0]} {Length:1 IOID:190 Value:[0]} {Length:1 IOID:193 Value:[0]} {Length:2 IOID:181 Value:[0 8]} {Length:2 IOID:182 Value:[0 5]} {Length:2 IOID:66 Value:[112 43]} {Length:2 IOID:205 Value:[61 30]} {Length:2 IOID:206 Value:[96 90]} {Length:2 IOID:84 Value:[0 0]} {Length:2 IOID:85 Value:[0 0]} {Length:2 IOID:115 Value:[0 0]} {Length:2 IOID:90 Value:[0 0]} {Length:2 IOID:192 Value:[0 0]} {Length:4 IOID:199 Value:[0 0 0 30]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 45 51 170]} {Length:4 IOID:83 Value:[0 0 0 0]} {Length:4 IOID:87 Value:[0 0 0 0]} {Length:4 IOID:100 Value:[0 0 0 247]} {Length:4 IOID:191 Value:[0 0 0 0]}] GenerationType:None} {UtimeMs:1528069070050 Utime:1528069070 Priority:1 Lat:491385900 Lng:170252500 Altitude:220 Angle:291 VisSat:18 Speed:88 EventID:66 Elements:[{Length:1 IOID:69 Value:[3]} {Length:1 IOID:240 Value:[1]} {Length:1 IOID:80 Value:[5]} {Length:1 IOID:21 Value:[3]} {Length:1 IOID:239 Value:[1]} {Length:1 IOID:81 Value:[0]} {Length:1 IOID:82 Value:[0]} {Length:1 IOID:89 Value:[0]} {Length:1 IOID:190 Value:[0]} {Length:1 IOID:193 Value:[0]} {Length:2 IOID:181 Value:[0 9]} {Length:2 IOID:182 Value:[0 5]} {Length:2 IOID:66 Value:[112 49]} {Length:2 IOID:205 Value:[121 216]} {Length:2 IOID:206 Value:[96 90]} {Length:2 IOID:84 Value:[0 0]} {Length:2 IOID:85 Value:[0 0]} {Length:2 IOID:115 Value:[0 0]} {Length:2 IOID:90 Value:[0 0]} {Length:2 IOID:192 Value:[0 0]} {Length:4 IOID:199 Value:[0 0 0 25]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 45 50 80]} {Length:4 IOID:83 Value:[0 0 0 0]} {Length:4 IOID:87 Value:[0 0 0 0]} {Length:4 IOID:100 Value:[0 0 0 247]} {Length:4 IOID:191 Value:[0 0 0 0]}] GenerationType:None}] Response:[0 5 202 254 1 40 4] Transport:UDP PacketID:51966 AvlPacketID:40 Codec15:<nil>}
 	// Decoded packet codec 8 extended:
-	// {IMEI:352093085698206 CodecID:142 NoOfData:1 Data:[{UtimeMs:1545914096000 Utime:1545914096 Priority:2 Lat:0 Lng:0 Altitude:0 Angle:0 VisSat:0 Speed:0 EventID:252 Elements:[{Length:1 IOID:239 Value:[0]} {Length:1 IOID:240 Value:[0]} {Length:1 IOID:21 Value:[5]} {Length:1 IOID:200 Value:[0]} {Length:1 IOID:69 Value:[2]} {Length:1 IOID:1 Value:[0]} {Length:1 IOID:113 Value:[0]} {Length:1 IOID:252 Value:[0]} {Length:2 IOID:181 Value:[0 0]} {Length:2 IOID:182 Value:[0 0]} {Length:2 IOID:66 Value:[48 86]} {Length:2 IOID:205 Value:[67 42]} {Length:2 IOID:206 Value:[96 100]} {Length:2 IOID:17 Value:[0 9]} {Length:2 IOID:18 Value:[255 34]} {Length:2 IOID:19 Value:[3 209]} {Length:2 IOID:15 Value:[0 0]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 0 0 0]}]}] Response:[0 5 202 254 1 1 1]}
+	// {IMEI:352093085698206 CodecID:142 NoOfData:1 Data:[{UtimeMs:1545914096000 Utime:1545914096 Priority:2 Lat:0 Lng:0 Altitude:0 Angle:0 VisSat:0 Speed:0 EventID:252 Elements:[{Length:1 IOID:239 Value:[0]} {Length:1 IOID:240 Value:[0]} {Length:1 IOID:21 Value:[5]} {Length:1 IOID:200 Value:[0]} {Length:1 IOID:69 Value:[2]} {Length:1 IOID:1 Value:[0]} {Length:1 IOID:113 Value:[0]} {Length:1 IOID:252 Value:[0]} {Length:2 IOID:181 Value:[0 0]} {Length:2 IOID:182 Value:[0 0]} {Length:2 IOID:66 Value:[48 86]} {Length:2 IOID:205 Value:[67 42]} {Length:2 IOID:206 Value:[96 100]} {Length:2 IOID:17 Value:[0 9]} {Length:2 IOID:18 Value:[255 34]} {Length:2 IOID:19 Value:[3 209]} {Length:2 IOID:15 Value:[0 0]} {Length:4 IOID:241 Value:[0 0 89 217]} {Length:4 IOID:16 Value:[0 0 0 0]}] GenerationType:None}] Response:[0 5 202 254 1 1 1] Transport:UDP PacketID:51966 AvlPacketID:1 Codec15:<nil>}
+}
+
+func ExampleDecodeTCP() {
+	// Codec 8 packet received over TCP
+	stringData := `000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF`
+
+	bs, _ := hex.DecodeString(stringData)
+	// decode a raw data byte slice
+	parsedData, err := DecodeTCP(&bs)
+	if err != nil {
+		log.Panicf("Error when decoding a bs, %v\n", err)
+	}
+	fmt.Printf("Decoded packet codec 8:\n%+v\n", parsedData.Data)
+
+	// test with Codec8 Extended packet
+	stringData = `000000000000004A8E010000016B412CEE000100000000000000000000000000000000010005000100010100010011001D00010010015E2C880002000B000000003544C87A000E000000001DD7E06A00000100002994`
+
+	bs, _ = hex.DecodeString(stringData)
+
+	// decode a raw data byte slice
+	parsedData, err = DecodeTCP(&bs)
+	if err != nil {
+		log.Panicf("Error when decoding a bs, %v\n", err)
+	}
+	fmt.Printf("Decoded packet codec 8 extended:\n%+v\n", parsedData.Data)
+
+	// Output:
+	// Decoded packet codec 8:
+	// [{UtimeMs:1560161086000 Utime:1560161086 Priority:1 Lat:0 Lng:0 Altitude:0 Angle:0 VisSat:0 Speed:0 EventID:1 Elements:[{Length:1 IOID:21 Value:[3]} {Length:1 IOID:1 Value:[1]} {Length:2 IOID:66 Value:[94 15]} {Length:4 IOID:241 Value:[0 0 96 26]} {Length:8 IOID:78 Value:[0 0 0 0 0 0 0 0]}] GenerationType:None}]
+	// Decoded packet codec 8 extended:
+	// [{UtimeMs:1560166592000 Utime:1560166592 Priority:1 Lat:0 Lng:0 Altitude:0 Angle:0 VisSat:0 Speed:0 EventID:1 Elements:[{Length:1 IOID:1 Value:[1]} {Length:2 IOID:17 Value:[0 29]} {Length:4 IOID:16 Value:[1 94 44 136]} {Length:8 IOID:11 Value:[0 0 0 0 53 68 200 122]} {Length:8 IOID:14 Value:[0 0 0 0 29 215 224 106]}] GenerationType:None}]
+}
+
+func ExampleDecodeTCP_codec16() {
+	// Codec 16 packet received over TCP
+	stringData := `000000000000005F10020000016BDBC7833000000000000000000000000000000000000B05040200010000030002000B00270042563A00000000016BDBC7871800000000000000000000000000000000000B05040200010000030002000B00260042563A00000200005FB3`
+
+	bs, _ := hex.DecodeString(stringData)
+	// decode a raw data byte slice
+	parsedData, err := DecodeTCP(&bs)
+	if err != nil {
+		log.Panicf("Error when decoding a bs, %v\n", err)
+	}
+
+	for _, avl := range parsedData.Data {
+		fmt.Printf("Generation type: %v, EventID: %v, Elements: %+v\n", avl.GenerationType, avl.EventID, avl.Elements)
+	}
+
+	// Output:
+	// Generation type: On Change, EventID: 11, Elements: [{Length:1 IOID:1 Value:[0]} {Length:1 IOID:3 Value:[0]} {Length:2 IOID:11 Value:[0 39]} {Length:2 IOID:66 Value:[86 58]}]
+	// Generation type: On Change, EventID: 11, Elements: [{Length:1 IOID:1 Value:[0]} {Length:1 IOID:3 Value:[0]} {Length:2 IOID:11 Value:[0 38]} {Length:2 IOID:66 Value:[86 58]}]
+}
+
+func ExampleDecodeTCP_codec15() {
+	// Codec 15 frame with data of RS232 connected peripheral
+	stringData := `00000000000000230F010B0000001B5D35A4A00352093081452251234741524D494E2C50494E472A0D0A010000DEDC`
+
+	bs, _ := hex.DecodeString(stringData)
+	// decode a raw data byte slice
+	parsedData, err := DecodeTCP(&bs)
+	if err != nil {
+		log.Panicf("Error when decoding a bs, %v\n", err)
+	}
+
+	fmt.Printf("Utime: %v, IMEI: %v, Payload: %q\n", parsedData.Codec15.Utime, parsedData.Codec15.IMEI, parsedData.Codec15.Payload)
+
+	// Output:
+	// Utime: 1563796640, IMEI: 352093081452251, Payload: "#GARMIN,PING*\r\n"
+}
+
+func TestDecodeTCPFraming(t *testing.T) {
+	valid := "000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF"
+
+	tests := []struct {
+		name string
+		data string
+		want error
+	}{
+		{"valid", valid, nil},
+		{"preamble", "00000001" + valid[8:], ErrPreamble},
+		{"declared length too long", "0000000000000037" + valid[16:], ErrDataLength},
+		{"declared length too short", "0000000000000035" + valid[16:], ErrDataLength},
+		{"trailing byte", valid + "00", ErrDataLength},
+		{"corrupted data", valid[:40] + "FF" + valid[42:], ErrCRC},
+		{"corrupted crc", valid[:len(valid)-4] + "C7CE", ErrCRC},
+	}
+
+	for _, tt := range tests {
+		bs, _ := hex.DecodeString(tt.data)
+		_, err := DecodeTCP(&bs)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%v: want error %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestDecodeError(t *testing.T) {
+	valid := "08010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E000000000000000001"
+
+	tests := []struct {
+		name   string
+		data   string
+		want   error
+		field  string
+		offset int
+		record int
+	}{
+		{"codec", "09" + valid[2:], ErrInvalidCodec, FieldCodecID, 8, -1},
+		{"priority", valid[:20] + "03" + valid[22:], ErrInvalidValue, FieldPriority, 18, 0},
+		{"angle", valid[:42] + "0169" + valid[46:], ErrInvalidValue, FieldAngle, 29, 0},
+		{"io count", valid[:54] + "06" + valid[56:], ErrCountMismatch, FieldIOCount, 35, 0},
+		{"number of data", valid[:len(valid)-2] + "02", ErrCountMismatch, FieldNoOfData, 61, -1},
+	}
+
+	for _, tt := range tests {
+		data, _ := hex.DecodeString(tt.data)
+		bs := encodeTCPFrame(data)
+		_, err := DecodeTCP(&bs)
+
+		var de *DecodeError
+		if !errors.As(err, &de) {
+			t.Errorf("%v: want DecodeError, got %v", tt.name, err)
+			continue
+		}
+		if !errors.Is(err, tt.want) || de.Field != tt.field || de.Offset != tt.offset || de.Record != tt.record {
+			t.Errorf("%v: want %v in %v at offset %v of record %v, got %v", tt.name, tt.want, tt.field, tt.offset, tt.record, err)
+		}
+	}
+}
+
+func TestDecodeTruncated(t *testing.T) {
+	// UDP packet without the second Number of Data
+	bs, _ := hex.DecodeString("005FCAFE0107000F3335323039333038353639383230360801000001669BF6FAB80000000000000000000000000000000000020200EF00F0000000")
+	if _, err := DecodeUDP(&bs); !errors.Is(err, ErrUnexpectedEnd) {
+		t.Errorf("DecodeUDP: want error %v, got %v", ErrUnexpectedEnd, err)
+	}
+
+	// variable length IO element declaring 5 Bytes of value, only 1 Byte present
+	bs, _ = hex.DecodeString("00010000000000000000000100100005AA")
+	if _, _, err := DecodeElements(&bs, 0, 0x8e); !errors.Is(err, ErrUnexpectedEnd) {
+		t.Errorf("DecodeElements: want error %v, got %v", ErrUnexpectedEnd, err)
+	}
+}
+
+func TestDecodeUDPIMEILength(t *testing.T) {
+	valid := "005FCAFE0107000F3335323039333038353639383230360801000001669BF6FAB80000000000000000000000000000000000020200EF00F000000001"
+
+	// IMEI length is 2 Bytes long, a nonzero high Byte is not ignored
+	bs, _ := hex.DecodeString(valid[:12] + "010F" + valid[16:])
+	_, err := DecodeUDP(&bs)
+	var de *DecodeError
+	if !errors.As(err, &de) || !errors.Is(err, ErrInvalidIMEI) || de.Field != FieldIMEILength || de.Offset != 6 {
+		t.Errorf("want %v in %v at offset 6, got %v", ErrInvalidIMEI, FieldIMEILength, err)
+	}
+}
+
+func TestResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		udp  bool
+		want string
+	}{
+		{"TCP codec 8", "000000000000004308020000016B40D57B480100000000000000000000000000000001010101000000000000016B40D5C198010000000000000000000000000000000101010101000000020000252C", false, "00000002"},
+		{"UDP codec 8", "005FCAFE0107000F3335323039333038353639383230360801000001669BF6FAB80000000000000000000000000000000000020200EF00F000000001", true, "0005cafe010701"},
+	}
+
+	for _, tt := range tests {
+		bs, _ := hex.DecodeString(tt.data)
+		var decoded Decoded
+		var err error
+		if tt.udp {
+			decoded, err = DecodeUDP(&bs)
+		} else {
+			decoded, err = DecodeTCP(&bs)
+		}
+		if err != nil {
+			t.Fatalf("%v: unexpected error %v", tt.name, err)
+		}
+		if got := hex.EncodeToString(decoded.Response); got != tt.want {
+			t.Errorf("%v: want response %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func ExampleDecodeIMEI() {
+	// IMEI login packet sent by a device when TCP session is opened
+	bs, _ := hex.DecodeString("000F333536333037303432343431303133")
+
+	imei, err := DecodeIMEI(&bs)
+	if err != nil {
+		// reject the device
+		fmt.Printf("%x\n", IMEIResponse(false))
+		return
+	}
+	fmt.Printf("IMEI: %v, response: %x\n", imei, IMEIResponse(true))
+
+	// Output:
+	// IMEI: 356307042441013, response: 01
+}
+
+func TestDecodeIMEI(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want error
+	}{
+		{"valid", "000F333536333037303432343431303133", nil},
+		{"invalid checksum", "000F333536333037303432343431303134", ErrIMEIPacket},
+		{"invalid length", "000E3335363330373034323434313031", ErrIMEIPacket},
+		{"not a digit", "000F33353633303730343234343130313A", ErrIMEIPacket},
+		{"AVL frame", "000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF", ErrIMEIPacket},
+	}
+
+	for _, tt := range tests {
+		bs, _ := hex.DecodeString(tt.data)
+		_, err := DecodeIMEI(&bs)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%v: want error %v, got %v", tt.name, tt.want, err)
+		}
+	}
 }
 
 func ExampleHumanDecoder_Human() {
@@ -263,3 +486,284 @@ func BenchmarkHuman(b *testing.B) {
 		}
 	}
 }
+
+func ExampleHAvlData_GetScaledValue() {
+	humanDecoder := HumanDecoder{}
+
+	// External Voltage of FM64 has Multiplier 0,001
+	el := Element{Length: 2, IOID: 66, Value: []byte{0x32, 0x49}}
+	decoded, err := humanDecoder.Human(&el, "FM64")
+	if err != nil {
+		log.Panicf("Error when converting human, %v\n", err)
+	}
+
+	raw, _ := decoded.GetFinalValue()
+	scaled, _ := decoded.GetScaledValue()
+	fmt.Printf("%v: raw %v, scaled %v %v\n", decoded.AvlEncodeKey.PropertyName, raw, scaled, decoded.Units())
+
+	// Output:
+	// External Voltage: raw 12873, scaled 12.873 V
+}
+
+func TestGetScaledValue(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+
+	tests := []struct {
+		family string
+		el     Element
+		want   interface{}
+		units  string
+	}{
+		{"FMBXY", Element{Length: 2, IOID: 84, Value: []byte{0x04, 0xD2}}, 123.4, "l"},
+		{"FMBXY", Element{Length: 2, IOID: 115, Value: []byte{0xFF, 0x9C}}, -10.0, "°C"},
+		{"FMBXY", Element{Length: 2, IOID: 45, Value: []byte{0x00, 0x0C}}, uint64(120), "kPa"},
+		{"FMBXY", Element{Length: 2, IOID: 181, Value: []byte{0x00, 0x0C}}, 1.2, ""},
+		{"FMBXY", Element{Length: 1, IOID: 21, Value: []byte{0x03}}, uint8(3), ""},
+	}
+
+	for _, tt := range tests {
+		decoded, err := humanDecoder.Human(&tt.el, tt.family)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := decoded.GetScaledValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want || decoded.Units() != tt.units {
+			t.Errorf("%v %v: want %v %v (%T), got %v %v (%T)", tt.family, tt.el.IOID, tt.want, tt.units, tt.want, got, decoded.Units(), got)
+		}
+	}
+}
+
+func TestGetScaledValueUnits(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+
+	// the scaled value has to be in the reported units
+	tests := []struct {
+		family string
+		el     Element
+		want   interface{}
+		units  string
+	}{
+		{FamilyFMBXY, Element{Length: 2, IOID: 66, Value: []byte{0x32, 0x49}}, 12.873, "V"},
+		{FamilyFM64, Element{Length: 2, IOID: 66, Value: []byte{0x32, 0x49}}, 12.873, "V"},
+		{FamilyFM36, Element{Length: 2, IOID: 66, Value: []byte{0x32, 0x49}}, 12.873, "V"},
+		{FamilyFM11XY, Element{Length: 2, IOID: 66, Value: []byte{0x32, 0x49}}, 12.873, "V"},
+		{FamilyFMBXY, Element{Length: 4, IOID: 72, Value: []byte{0x00, 0x00, 0x00, 0xFA}}, 25.0, "°C"},
+		{FamilyFM64, Element{Length: 2, IOID: 72, Value: []byte{0x00, 0xFA}}, 25.0, "°C"},
+		{FamilyFM36, Element{Length: 2, IOID: 72, Value: []byte{0x00, 0xFA}}, 25.0, "°C"},
+		{FamilyFM11XY, Element{Length: 4, IOID: 72, Value: []byte{0xFF, 0xFF, 0xFF, 0x06}}, -25.0, "°C"},
+		{FamilyFMBXY, Element{Length: 2, IOID: 13, Value: []byte{0x00, 0xFA}}, 2.5, "l/h"},
+		// IO 13 is Module ID in FM64, FM36 and FM11XY do not know it
+		{FamilyFM64, Element{Length: 8, IOID: 13, Value: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xFA}}, "\x00\x00\x00\x00\x00\x00\x00\xfa", ""},
+		{FamilyFM36, Element{Length: 2, IOID: 13, Value: []byte{0x00, 0xFA}}, nil, ""},
+		{FamilyFM11XY, Element{Length: 2, IOID: 13, Value: []byte{0x00, 0xFA}}, nil, ""},
+	}
+
+	for _, tt := range tests {
+		decoded, err := humanDecoder.Human(&tt.el, tt.family)
+		if tt.want == nil {
+			if err == nil {
+				t.Errorf("%v %v: want unknown element, got %v", tt.family, tt.el.IOID, decoded.AvlEncodeKey.PropertyName)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := decoded.GetScaledValue()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) || decoded.Units() != tt.units {
+			t.Errorf("%v %v: want %v %v (%T), got %v %v (%T)", tt.family, tt.el.IOID, tt.want, tt.units, tt.want, got, decoded.Units(), got)
+		}
+	}
+}
+
+func TestGetScaledValueInteger(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        AvlEncodeKey
+		value      []byte
+		want       interface{}
+		multiplier bool
+	}{
+		{"uint64 above 2^53", AvlEncodeKey{Bytes: "8", Type: "Unsigned", Multiplier: "10", FinalConversion: "toUint64"}, []byte{0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}, uint64(90071992547409930), true},
+		{"uint64 overflow", AvlEncodeKey{Bytes: "8", Type: "Unsigned", Multiplier: "10", FinalConversion: "toUint64"}, []byte{0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, nil, true},
+		{"int64 negative", AvlEncodeKey{Bytes: "8", Type: "Signed", Multiplier: "100", FinalConversion: "toInt64"}, []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFE}, int64(-200), true},
+		{"int64 overflow", AvlEncodeKey{Bytes: "8", Type: "Signed", Multiplier: "100", FinalConversion: "toInt64"}, []byte{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}, nil, true},
+		{"comma", AvlEncodeKey{Bytes: "2", Type: "Unsigned", Multiplier: " 0,5 ", FinalConversion: "toUint16"}, []byte{0x00, 0x03}, 1.5, true},
+		{"description", AvlEncodeKey{Bytes: "1", Type: "Unsigned", Multiplier: "acc and braking: 0.01", FinalConversion: "toUint8"}, []byte{0x7B}, uint8(123), false},
+		{"dash", AvlEncodeKey{Bytes: "1", Type: "Unsigned", Multiplier: "-", FinalConversion: "toUint8"}, []byte{0x7B}, uint8(123), false},
+	}
+
+	for _, tt := range tests {
+		// typed fields are set when a dictionary is loaded
+		tt.key.parse()
+		el := Element{Length: uint16(len(tt.value)), Value: tt.value}
+		decoded := HAvlData{AvlEncodeKey: &tt.key, Element: &el}
+		if _, ok := decoded.Multiplier(); ok != tt.multiplier {
+			t.Errorf("%v: want multiplier %v, got %v", tt.name, tt.multiplier, ok)
+		}
+		got, err := decoded.GetScaledValue()
+		if tt.want == nil {
+			if err == nil {
+				t.Errorf("%v: want overflow error, got %v", tt.name, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%v: want %v (%T), got %v (%T) %v", tt.name, tt.want, tt.want, got, got, err)
+		}
+	}
+}
+
+func TestGenerationTypeString(t *testing.T) {
+	want := []string{"None", "On Exit", "On Entrance", "On Both", "Reserved", "Hysteresis", "On Change", "Eventual", "Periodical", "GenerationType(9)"}
+	for i, name := range want {
+		if got := GenerationType(i).String(); got != name {
+			t.Errorf("GenerationType(%v): want %q, got %q", i, name, got)
+		}
+	}
+}
+
+func TestGenerationType(t *testing.T) {
+	codec8 := "000000000000003608010000016B40D8EA30010000000000000000000000000000000105021503010101425E0F01F10000601A014E0000000000000000010000C7CF"
+	codec16 := "000000000000005F10020000016BDBC7833000000000000000000000000000000000000B05040200010000030002000B00270042563A00000000016BDBC7871800000000000000000000000000000000000B05040200010000030002000B00260042563A00000200005FB3"
+
+	bs, _ := hex.DecodeString(codec8)
+	decoded, err := DecodeTCP(&bs)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if decoded.Data[0].GenerationType != GenerationNone {
+		t.Errorf("codec 8: want %v, got %v", GenerationNone, decoded.Data[0].GenerationType)
+	}
+
+	// wire value 0 is On Exit
+	data, _ := hex.DecodeString(codec16[16:72] + "00" + codec16[74:len(codec16)-8])
+	bs = encodeTCPFrame(data)
+	decoded, err = DecodeTCP(&bs)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if decoded.Data[0].GenerationType != GenerationOnExit {
+		t.Errorf("codec 16: want %v, got %v", GenerationOnExit, decoded.Data[0].GenerationType)
+	}
+
+	// wire values above 7 are not defined
+	data, _ = hex.DecodeString(codec16[16:72] + "08" + codec16[74:len(codec16)-8])
+	bs = encodeTCPFrame(data)
+	_, err = DecodeTCP(&bs)
+	var de *DecodeError
+	if !errors.As(err, &de) || !errors.Is(err, ErrInvalidValue) || de.Field != FieldGenerationType || de.Offset != 36 || de.Record != 0 {
+		t.Errorf("want %v in %v at offset 36 of record 0, got %v", ErrInvalidValue, FieldGenerationType, err)
+	}
+
+	// a codec 16 record must carry the generation type
+	decoded.Data[0].GenerationType = GenerationNone
+	if _, err := EncodeTCP(decoded); err == nil {
+		t.Error("want error for codec 16 record without generation type")
+	}
+}
+
+func ExampleHumanDecoder_Label() {
+	humanDecoder := HumanDecoder{}
+
+	// Sleep Mode of FMBXY family
+	el := Element{Length: 1, IOID: 200, Value: []byte{0x02}}
+	label, err := humanDecoder.Label(&el, "FMBXY")
+	if err != nil {
+		log.Panicf("Unable to get label, %v\n", err)
+	}
+	fmt.Println(label)
+
+	// Data Mode of FM11XY family
+	el = Element{Length: 1, IOID: 80, Value: []byte{0x01}}
+	label, err = humanDecoder.Label(&el, "FM11XY")
+	if err != nil {
+		log.Panicf("Unable to get label, %v\n", err)
+	}
+	fmt.Println(label)
+
+	// Output:
+	// Deep Sleep
+	// Home On Move
+}
+
+func TestLabel(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+
+	tests := []struct {
+		family string
+		el     Element
+		want   string
+		err    bool
+	}{
+		{"FMBXY", Element{Length: 1, IOID: 239, Value: []byte{0x01}}, "Ignition On", false},
+		{"FMBXY", Element{Length: 1, IOID: 69, Value: []byte{0x03}}, "In Sleep State", false},
+		{"FM64", Element{Length: 1, IOID: 71, Value: []byte{0x02}}, "GNSS ON, Without Fix", false},
+		{"FM36", Element{Length: 1, IOID: 200, Value: []byte{0x01}}, "Deep Sleep Mode", false},
+		{"FM36", Element{Length: 1, IOID: 69, Value: []byte{0x03}}, "Working, GPS Fix Acquired", false},
+		{"FM64", Element{Length: 1, IOID: 200, Value: []byte{0x01}}, "Deep Sleep", false},
+		{"FMBXY", Element{Length: 1, IOID: 200, Value: []byte{0x09}}, "", true},
+		{"FMBXY", Element{Length: 1, IOID: 21, Value: []byte{0x03}}, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := humanDecoder.Label(&tt.el, tt.family)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("%v %v: want %q (error %v), got %q (%v)", tt.family, tt.el.IOID, tt.want, tt.err, got, err)
+		}
+	}
+}
+
+func ExampleHumanDecoder_Flags() {
+	humanDecoder := HumanDecoder{}
+
+	// Door Status of FMBXY family, front left door and trunk are open
+	el := Element{Length: 2, IOID: 90, Value: []byte{0x21, 0x00}}
+	flags, err := humanDecoder.Flags(&el, "FMBXY")
+	if err != nil {
+		log.Panicf("Unable to get flags, %v\n", err)
+	}
+	fmt.Println(flags["Front left door open"], flags["Front right door open"], flags["Trunk open"])
+
+	// Output:
+	// true false true
+}
+
+func TestFlags(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+
+	// Security State Flags, Byte0 is LSB
+	el := Element{Length: 8, IOID: 132, Value: []byte{0x00, 0x80, 0x01, 0x40, 0x02, 0x00, 0x00, 0x03}}
+	flags, err := humanDecoder.Flags(&el, "FM11XY")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"CAN1 connected", "CAN1 required", "Ignition on", "Engine working", "Front left door open", "CAN module sleep mode"}
+	set := 0
+	for _, v := range flags {
+		if v {
+			set++
+		}
+	}
+	for _, name := range want {
+		if !flags[name] {
+			t.Errorf("want flag %q set, got %v", name, flags)
+		}
+	}
+	if set != len(want) {
+		t.Errorf("want %v flags set, got %v", len(want), set)
+	}
+
+	// element without bits
+	el = Element{Length: 1, IOID: 239, Value: []byte{0x01}}
+	if _, err := humanDecoder.Flags(&el, "FMBXY"); err == nil {
+		t.Errorf("want error for element without bits")
+	}
+}
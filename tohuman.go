@@ -2,13 +2,16 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-package main
+package teltonikaparser
 
 import (
-	"encoding/json"
 	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"sync"
+
 	"github.com/filipkroca/b2n"
-	"github.com/filipkroca/teltonikaparser/teltonikajson"
 )
 
 // HAvlData represent human readable set of a pointer to an AvlEncodeKey Decoding key and a pointer to IO element with RAW data
@@ -17,44 +20,80 @@ type HAvlData struct {
 	Element      *Element
 }
 
-// HumanDecoder is responsible for decoding
+// HumanDecoder is responsible for decoding, it is safe for concurrent use. The zero value uses the built-in dictionaries,
+// which are compiled in and shared by all decoders
 type HumanDecoder struct {
-	elements map[string]map[uint16]AvlEncodeKey
+	seen     uint64 // counter of detections, orders IMEIs of history by recent use, accessed atomically and first for 64-bit alignment
+	mu       sync.RWMutex
+	elements map[string]map[uint16]AvlEncodeKey // nil until LoadJSON or LoadCSV, maps are never modified once set, they are replaced
+	history  map[string]*familyHistory          // history of device family detection per IMEI
+	version  uint64                             // incremented when dictionaries are replaced
+}
+
+// NewHumanDecoder return a HumanDecoder with the built-in dictionaries
+func NewHumanDecoder() *HumanDecoder {
+	return &HumanDecoder{}
 }
 
 // AvlEncodeKey represent parsed element values from JSON
 type AvlEncodeKey struct {
-	No              string `json:"No"`
-	PropertyName    string `json:"PropertyName"`
-	Bytes           string `json:"Bytes"`
-	Type            string `json:"Type"`
-	Min             string `json:"Min"`
-	Max             string `json:"Max"`
-	Multiplier      string `json:"Multiplier"`
-	Units           string `json:"Units"`
-	Description     string `json:"Description"`
-	HWSupport       string `json:"HWSupport"`
-	ParametrGroup   string `json:"Parametr Group"`
-	FinalConversion string `json:"FinalConversion"`
-}
-
-// Human takes a pointer to Element, device type ["FMBXY", "FM64", "FM36", "FM11XY"] and return a pointer to decoding key
+	No              string            `json:"No"`
+	PropertyName    string            `json:"PropertyName"`
+	Bytes           string            `json:"Bytes"`
+	Type            string            `json:"Type"`
+	Min             string            `json:"Min"`
+	Max             string            `json:"Max"`
+	Multiplier      string            `json:"Multiplier"`
+	Units           string            `json:"Units"`
+	Description     string            `json:"Description"`
+	HWSupport       string            `json:"HWSupport"`
+	ParametrGroup   string            `json:"Parametr Group"`
+	Values          map[string]string `json:"Values,omitempty"` // labels of enumerated values, keyed by decimal value
+	Bits            map[string]string `json:"Bits,omitempty"`   // names of flags of a bitmask, keyed by bit number, bit 0 is LSB
+	FinalConversion string            `json:"FinalConversion"`
+
+	// typed fields are parsed once when the dictionary is built, by dictgen or LoadJSON and LoadCSV, decoding reads them instead of the strings
+	size       int     // Bytes, 0 if Variable
+	signed     bool    // Type is Signed
+	multiplier float64 // Multiplier, 0 if the key has no numeric multiplier
+	units      string  // Units, empty if the key has no units
+}
+
+// Human takes a pointer to Element and device, a family [FamilyFMBXY, FamilyFM64, FamilyFM36, FamilyFM11XY] or a model from the catalogue like "FMB920",
+// and return a pointer to decoding key, elements which the model can not produce according to HWSupport are rejected
 func (h *HumanDecoder) Human(el *Element, device string) (*HAvlData, error) {
-	// init decoding key
-	if len(h.elements) == 0 {
-		h.loadElements()
+	elements := h.dictionaries()
+
+	family, model := device, Model{}
+	if _, ok := elements[device]; !ok {
+		m, ok := LookupModel(device)
+		if _, known := elements[m.Family]; !ok || !known {
+			return nil, fmt.Errorf("Unknown device %v", device)
+		}
+		family, model = m.Family, m
 	}
+	return human(elements, el, family, model)
+}
 
+// human pairs Element with decoding key of family, HWSupport is checked if model is not empty
+func human(elements map[string]map[uint16]AvlEncodeKey, el *Element, family string, model Model) (*HAvlData, error) {
 	// check if Element is valid
 	if !((*el).Length > 0 && (*el).IOID > 0 && len((*el).Value) > 0) {
 		return nil, fmt.Errorf("Unable to decode empty element")
 	}
 
 	// find decode key and pair it
-	avl, ok := h.elements[device][(*el).IOID]
+	avl, ok := elements[family][(*el).IOID]
 	if !ok {
 		return nil, fmt.Errorf("Unknown element %v", (*el).IOID)
 	}
+	if model.Name != "" && !avl.Supports(model) {
+		return nil, fmt.Errorf("Element %v %v is not supported by %v", (*el).IOID, avl.PropertyName, model.Name)
+	}
+
+	// Values and Bits are shared by all decoders, the caller gets own copies
+	avl.Values = copyStrings(avl.Values)
+	avl.Bits = copyStrings(avl.Bits)
 
 	// return pointer to merged struct with decode key AvlEncodeKey and data Element
 	havl := HAvlData{
@@ -64,43 +103,65 @@ func (h *HumanDecoder) Human(el *Element, device string) (*HAvlData, error) {
 	return &havl, nil
 }
 
-// AvlDataToHuman takes a pointer to a slice of AvlData and return a slice with data
+// copyStrings return a copy of m, nil if m is nil
+func copyStrings(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+// Label takes a pointer to Element and device, a family or a model as in Human, and return a label of the enumerated value,
+// for example "Ignition On" or "Deep Sleep"
+func (h *HumanDecoder) Label(el *Element, device string) (string, error) {
+	decoded, err := h.Human(el, device)
+	if err != nil {
+		return "", err
+	}
+	return decoded.Label()
+}
+
+// Flags takes a pointer to Element and device, a family or a model as in Human, and return named flags of a bitmask,
+// for example "Front left door open": true
+func (h *HumanDecoder) Flags(el *Element, device string) (map[string]bool, error) {
+	decoded, err := h.Human(el, device)
+	if err != nil {
+		return nil, err
+	}
+	return decoded.Flags()
+}
+
+// AvlDataToHuman takes a pointer to a slice of AvlData and return a slice with data,
+// the device family is detected by DetectFamily
 func (h *HumanDecoder) AvlDataToHuman(data *[]AvlData) ([][][]string, error) {
-	// init decoding key
-	if len(h.elements) == 0 {
-		h.loadElements()
+	detected, err := h.DetectFamily(data)
+	if err != nil {
+		return nil, err
 	}
 
-	codec := "FMBXY"
 	var output = make([][][]string, len(*data))
 
-autoDecode:
 	// loop over raw data
 	for i, val := range *data {
 		output[i] = make([][]string, len(val.Elements))
 		// loop over Elements
 		for j, ioel := range val.Elements {
 			// decode to human readable format
-			decoded, err := h.Human(&ioel, codec)
+			decoded, err := h.Human(&ioel, detected.Family)
 			if err != nil {
-				// log.Panicf("Error when converting human, %v\n", err)
 				continue
 			}
 
 			// get final decoded value to value which is specified in ./teltonikajson/ in paramether FinalConversion
-			if val, err := (*decoded).GetFinalValue(); err != nil {
-				// detect device family
-				if codec == "FMBXY" {
-					codec = "FM64"
-				} else if codec == "FM64" {
-					codec = "FM36"
-				} else if codec == "FM36" {
-					codec = "FM11XY"
-				} else {
-					return nil, fmt.Errorf("Unable to GetFinalValue() %v", err)
-				}
-				goto autoDecode
-			} else if val != nil {
+			val, err := (*decoded).GetFinalValue()
+			if err != nil {
+				return nil, fmt.Errorf("Unable to GetFinalValue() of %v detected as %v, %v", ioel.IOID, detected.Family, err)
+			}
+			if val != nil {
 				output[i][j] = []string{fmt.Sprintf("%v", decoded.Element.IOID), fmt.Sprintf("%v", decoded.AvlEncodeKey.PropertyName), fmt.Sprintf("%v", val)}
 			}
 		}
@@ -108,111 +169,85 @@ autoDecode:
 	return output, nil
 }
 
-// loadElements parses ./decoding/.. into slice
-func (h *HumanDecoder) loadElements() {
-	// make map
-	h.elements = make(map[string]map[uint16]AvlEncodeKey)
-
-	// read our opened json as a byte array.
-	byteValue := []byte(teltonikajson.FMBXY)
-	fmbxy := make(map[uint16]AvlEncodeKey)
-	// h.elements["FMBXY"] = make(map[uint16]AvlEncodeKey)
-	err := json.Unmarshal(byteValue, &fmbxy)
-	if err != nil {
-		// log.Panic(err)
-	}
-	h.elements["FMBXY"] = fmbxy
-
-	// read our opened json as a byte array.
-	byteValue = []byte(teltonikajson.FM64)
-	fm64 := make(map[uint16]AvlEncodeKey)
-	err = json.Unmarshal(byteValue, &fm64)
-	if err != nil {
-		// log.Panic(err)
-	}
-	h.elements["FM64"] = fm64
-
-	// read our opened json as a byte array.
-	byteValue = []byte(teltonikajson.FM36)
-	fm36 := make(map[uint16]AvlEncodeKey)
-	err = json.Unmarshal(byteValue, &fm36)
-	if err != nil {
-		// log.Panic(err)
+// dictionaries return decoding keys of h, the shared built-in dictionaries if nothing was loaded
+func (h *HumanDecoder) dictionaries() map[string]map[uint16]AvlEncodeKey {
+	h.mu.RLock()
+	elements := h.elements
+	h.mu.RUnlock()
+	if elements == nil {
+		return builtinElements()
 	}
-	h.elements["FM36"] = fm36
+	return elements
+}
 
-	// read our opened json as a byte array.
-	byteValue = []byte(teltonikajson.FM11XY)
-	fm11XY := make(map[uint16]AvlEncodeKey)
-	err = json.Unmarshal(byteValue, &fm11XY)
-	if err != nil {
-		// log.Panic(err)
-	}
-	h.elements["FM11XY"] = fm11XY
+//go:generate go run ./internal/dictgen -o dictionaries_gen.go
 
+// builtinElements return the built-in dictionaries compiled from ./teltonikajson/ by go generate, they must not be modified
+func builtinElements() map[string]map[uint16]AvlEncodeKey {
+	return builtinDictionaries
 }
 
 // GetFinalValue return decimal value, if necesarry with float, return should be empty interface because there is many values to return
 func (h *HAvlData) GetFinalValue() (interface{}, error) {
 
 	if h.AvlEncodeKey.FinalConversion == "toBool" {
-		if h.AvlEncodeKey.Bytes != "1" || h.AvlEncodeKey.Type != "Unsigned" || len(h.Element.Value) != 1 {
+		if h.AvlEncodeKey.size != 1 || h.AvlEncodeKey.signed || len(h.Element.Value) != 1 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr to Bool %v", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName)
 		}
 		return (h.Element.Value[0] == 0x01), nil
 	}
 
 	if h.AvlEncodeKey.FinalConversion == "toUint8" {
-		if h.AvlEncodeKey.Bytes != "1" || h.AvlEncodeKey.Type != "Unsigned" || len(h.Element.Value) != 1 {
+		if h.AvlEncodeKey.size != 1 || h.AvlEncodeKey.signed || len(h.Element.Value) != 1 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr to Uint8 %v, original value %x", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName, h.Element.Value)
 		}
 		return b2n.ParseBs2Uint8(&h.Element.Value, 0)
 	}
 
 	if h.AvlEncodeKey.FinalConversion == "toUint16" {
-		if h.AvlEncodeKey.Bytes != "2" || h.AvlEncodeKey.Type != "Unsigned" || len(h.Element.Value) != 2 {
+		if h.AvlEncodeKey.size != 2 || h.AvlEncodeKey.signed || len(h.Element.Value) != 2 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr to Uint16 %v", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName)
 		}
 		return b2n.ParseBs2Uint16(&h.Element.Value, 0)
 	}
 
 	if h.AvlEncodeKey.FinalConversion == "toUint32" {
-		if h.AvlEncodeKey.Bytes != "4" || h.AvlEncodeKey.Type != "Unsigned" || len(h.Element.Value) != 4 {
+		if h.AvlEncodeKey.size != 4 || h.AvlEncodeKey.signed || len(h.Element.Value) != 4 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr to Uint32 %v", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName)
 		}
 		return b2n.ParseBs2Uint32(&h.Element.Value, 0)
 	}
 
 	if h.AvlEncodeKey.FinalConversion == "toUint64" {
-		if h.AvlEncodeKey.Bytes != "8" || h.AvlEncodeKey.Type != "Unsigned" || len(h.Element.Value) != 8 {
+		if h.AvlEncodeKey.size != 8 || h.AvlEncodeKey.signed || len(h.Element.Value) != 8 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr to Uint64 %v", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName)
 		}
 		return b2n.ParseBs2Uint64(&h.Element.Value, 0)
 	}
 
 	if h.AvlEncodeKey.FinalConversion == "toInt8" {
-		if h.AvlEncodeKey.Bytes != "1" || h.AvlEncodeKey.Type != "Signed" || len(h.Element.Value) != 1 {
+		if h.AvlEncodeKey.size != 1 || !h.AvlEncodeKey.signed || len(h.Element.Value) != 1 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr to Int8 %v", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName)
 		}
 		return b2n.ParseBs2Int8TwoComplement(&h.Element.Value, 0)
 	}
 
 	if h.AvlEncodeKey.FinalConversion == "toInt16" {
-		if h.AvlEncodeKey.Bytes != "2" || h.AvlEncodeKey.Type != "Signed" || len(h.Element.Value) != 2 {
+		if h.AvlEncodeKey.size != 2 || !h.AvlEncodeKey.signed || len(h.Element.Value) != 2 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr to Int16 %v", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName)
 		}
 		return b2n.ParseBs2Int16TwoComplement(&h.Element.Value, 0)
 	}
 
 	if h.AvlEncodeKey.FinalConversion == "toInt32" {
-		if h.AvlEncodeKey.Bytes != "4" || h.AvlEncodeKey.Type != "Signed" || len(h.Element.Value) != 4 {
+		if h.AvlEncodeKey.size != 4 || !h.AvlEncodeKey.signed || len(h.Element.Value) != 4 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr to Int32 %v", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName)
 		}
 		return b2n.ParseBs2Int32TwoComplement(&h.Element.Value, 0)
 	}
 
 	if h.AvlEncodeKey.FinalConversion == "toInt64" {
-		if h.AvlEncodeKey.Bytes != "8" || h.AvlEncodeKey.Type != "Signed" || len(h.Element.Value) != 8 {
+		if h.AvlEncodeKey.size != 8 || !h.AvlEncodeKey.signed || len(h.Element.Value) != 8 {
 			return nil, fmt.Errorf("Unable to convert %vBytes long parametr, %vBytes real long parametr, to Int64 %v", h.AvlEncodeKey.Bytes, len(h.Element.Value), h.AvlEncodeKey.PropertyName)
 		}
 		return b2n.ParseBs2Int64TwoComplement(&h.Element.Value, 0)
@@ -220,3 +255,130 @@ func (h *HAvlData) GetFinalValue() (interface{}, error) {
 
 	return string(h.Element.Value), nil
 }
+
+// GetScaledValue return GetFinalValue multiplied by Multiplier of the decoding key, float64 is returned if the multiplier is fractional,
+// whole multipliers keep the integer type, uint64 for unsigned and int64 for signed values, an overflow is an error.
+// Values without a numeric multiplier are returned unchanged
+func (h *HAvlData) GetScaledValue() (interface{}, error) {
+	val, err := h.GetFinalValue()
+	if err != nil {
+		return nil, err
+	}
+
+	multiplier, ok := h.Multiplier()
+	if !ok || multiplier == 1 {
+		return val, nil
+	}
+
+	var u uint64
+	var i int64
+	signed := false
+	switch v := val.(type) {
+	case uint8:
+		u = uint64(v)
+	case uint16:
+		u = uint64(v)
+	case uint32:
+		u = uint64(v)
+	case uint64:
+		u = v
+	case int8:
+		i, signed = int64(v), true
+	case int16:
+		i, signed = int64(v), true
+	case int32:
+		i, signed = int64(v), true
+	case int64:
+		i, signed = v, true
+	default:
+		return val, nil
+	}
+
+	// whole multiplier keeps an integer
+	if multiplier == math.Trunc(multiplier) {
+		if signed {
+			if multiplier < math.MaxInt64 {
+				m := int64(multiplier)
+				if scaled := i * m; scaled/m == i {
+					return scaled, nil
+				}
+			}
+		} else if multiplier < math.MaxUint64 {
+			if hi, scaled := bits.Mul64(u, uint64(multiplier)); hi == 0 {
+				return scaled, nil
+			}
+		}
+		return nil, fmt.Errorf("Unable to scale %v by %v, %v overflows", h.AvlEncodeKey.PropertyName, h.AvlEncodeKey.Multiplier, val)
+	}
+
+	n := float64(u)
+	if signed {
+		n = float64(i)
+	}
+	// divide by a whole divisor if possible, 12873 / 1000 is 12.873 while 12873 * 0.001 is 12.873000000000001
+	if divisor := math.Round(1 / multiplier); math.Abs(divisor-1/multiplier) < 1e-9 {
+		return n / divisor, nil
+	}
+	return n * multiplier, nil
+}
+
+// Multiplier return Multiplier of the decoding key parsed when the dictionary was built, "0,1" and "0.1" are accepted,
+// ok is false if the key has no numeric multiplier
+func (h *HAvlData) Multiplier() (multiplier float64, ok bool) {
+	if h.AvlEncodeKey.multiplier <= 0 {
+		return 1, false
+	}
+	return h.AvlEncodeKey.multiplier, true
+}
+
+// Units return Units of the decoding key, empty string if the key has no units
+func (h *HAvlData) Units() string {
+	return h.AvlEncodeKey.units
+}
+
+// Label return a label of the enumerated value from Values of the decoding key
+func (h *HAvlData) Label() (string, error) {
+	if len(h.AvlEncodeKey.Values) == 0 {
+		return "", fmt.Errorf("Element %v %v has no enumerated values", h.Element.IOID, h.AvlEncodeKey.PropertyName)
+	}
+
+	val, err := h.Value()
+	if err != nil {
+		return "", err
+	}
+	if val.Kind() != KindUint && val.Kind() != KindInt && val.Kind() != KindBool {
+		return "", fmt.Errorf("Element %v %v is not a number", h.Element.IOID, h.AvlEncodeKey.PropertyName)
+	}
+
+	key := val.String()
+	if val.Kind() == KindBool {
+		key = strconv.FormatUint(val.Uint64(), 10)
+	}
+
+	label, ok := h.AvlEncodeKey.Values[key]
+	if !ok {
+		return "", fmt.Errorf("Element %v %v has no label for value %v", h.Element.IOID, h.AvlEncodeKey.PropertyName, val)
+	}
+	return label, nil
+}
+
+// Flags return named flags of a bitmask described by Bits of the decoding key, bits are counted from LSB of the big endian value
+func (h *HAvlData) Flags() (map[string]bool, error) {
+	if len(h.AvlEncodeKey.Bits) == 0 {
+		return nil, fmt.Errorf("Element %v %v is not a bitmask", h.Element.IOID, h.AvlEncodeKey.PropertyName)
+	}
+
+	value := h.Element.Value
+	flags := make(map[string]bool, len(h.AvlEncodeKey.Bits))
+	for key, name := range h.AvlEncodeKey.Bits {
+		bit, err := strconv.Atoi(key)
+		if err != nil || bit < 0 {
+			return nil, fmt.Errorf("Element %v %v has invalid bit number %q", h.Element.IOID, h.AvlEncodeKey.PropertyName, key)
+		}
+
+		// bits which do not fit the value are not set
+		i := len(value) - 1 - bit/8
+		flags[name] = i >= 0 && value[i]&(1<<uint(bit%8)) != 0
+	}
+	return flags, nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"sync"
+	"time"
+)
+
+// maxUDPPacketSize is the maximum size of UDP payload
+const maxUDPPacketSize = 65535
+
+// defaultIdleTimeout is used when UDPServer.IdleTimeout is zero
+const defaultIdleTimeout = 30 * time.Minute
+
+// UDPServer receives packets of Teltonika devices over UDP, decodes them by DecodeUDP, sends the acknowledgement
+// back to the source address and delivers them to the Handler and to the Packets channel. Devices retransmit a packet when the acknowledgement
+// is lost, so the server tracks the last AVL packet ID of every IMEI and a retransmitted packet is only acknowledged again.
+type UDPServer struct {
+	Addr        string         // UDP address to listen on by ListenAndServe
+	Handler     Handler        // Handler called with every unique decoded packet, packets are delivered one by one
+	Packets     chan<- Decoded // Channel receiving every unique decoded packet after Handler, reading blocks until the packet is sent
+	IdleTimeout time.Duration  // IMEI not heard for IdleTimeout is forgotten, a zero value means 30 minutes
+	ErrorLog    *log.Logger    // Logger for errors of packets, if nil the log package's standard logger is used
+
+	mu           sync.Mutex
+	lastPacketID map[string]lastPacket // last AVL packet ID per IMEI
+	lastPrune    time.Time             // time of the last removal of idle IMEIs
+}
+
+// lastPacket is the last AVL packet ID received from IMEI
+type lastPacket struct {
+	id   uint8
+	seen time.Time
+}
+
+// ListenAndServe listens on the UDP address s.Addr and calls Serve
+func (s *UDPServer) ListenAndServe(ctx context.Context) error {
+	conn, err := net.ListenPacket("udp", s.Addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, conn)
+}
+
+// Serve reads packets from conn until ctx is done, then conn is closed and Serve returns nil
+func (s *UDPServer) Serve(ctx context.Context, conn net.PacketConn) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// close the connection on shutdown to unblock reading
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	buf := make([]byte, maxUDPPacketSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				continue
+			}
+			return err
+		}
+
+		// decoded Elements refer to the packet, so it can not share the buffer
+		packet := make([]byte, n)
+		copy(packet, buf[:n])
+
+		decoded, err := DecodeUDP(&packet)
+		if err != nil {
+			s.logf("teltonikaparser: packet from %v, %v", addr, err)
+			continue
+		}
+
+		if _, err := conn.WriteTo(decoded.Response, addr); err != nil {
+			s.logf("teltonikaparser: IMEI %v, writing response to %v failed, %v", decoded.IMEI, addr, err)
+		}
+
+		if s.isDuplicate(&decoded) {
+			continue
+		}
+
+		if s.Handler != nil {
+			s.Handler(decoded)
+		}
+		if s.Packets != nil {
+			select {
+			case s.Packets <- decoded:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
+
+// isDuplicate reports whether the packet has the same AVL packet ID as the previous packet of the IMEI and remembers it,
+// IMEIs idle for longer than IdleTimeout are forgotten
+func (s *UDPServer) isDuplicate(d *Decoded) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.lastPacketID == nil {
+		s.lastPacketID = make(map[string]lastPacket)
+	}
+
+	now := time.Now()
+	timeout := s.IdleTimeout
+	if timeout <= 0 {
+		timeout = defaultIdleTimeout
+	}
+
+	// sweep idle IMEIs at most once per timeout
+	if now.Sub(s.lastPrune) >= timeout {
+		for imei, last := range s.lastPacketID {
+			if now.Sub(last.seen) > timeout {
+				delete(s.lastPacketID, imei)
+			}
+		}
+		s.lastPrune = now
+	}
+
+	last, ok := s.lastPacketID[d.IMEI]
+	s.lastPacketID[d.IMEI] = lastPacket{id: d.AvlPacketID, seen: now}
+	return ok && now.Sub(last.seen) <= timeout && last.id == d.AvlPacketID
+}
+
+// logf logs an error of a packet to ErrorLog
+func (s *UDPServer) logf(format string, args ...interface{}) {
+	if s.ErrorLog != nil {
+		s.ErrorLog.Printf(format, args...)
+	} else {
+		log.Printf(format, args...)
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Kind represents a type of Value
+type Kind uint8
+
+// Kinds of Value
+const (
+	KindInvalid Kind = iota
+	KindBool
+	KindInt
+	KindUint
+	KindFloat
+	KindString
+	KindBytes
+)
+
+// String returns name of Kind
+func (k Kind) String() string {
+	switch k {
+	case KindInvalid:
+		return "Invalid"
+	case KindBool:
+		return "Bool"
+	case KindInt:
+		return "Int"
+	case KindUint:
+		return "Uint"
+	case KindFloat:
+		return "Float"
+	case KindString:
+		return "String"
+	case KindBytes:
+		return "Bytes"
+	}
+	return fmt.Sprintf("Kind(%d)", uint8(k))
+}
+
+// Value represents a converted value of IO element, it can be read by any accessor no matter which FinalConversion was used,
+// accessors convert the value to the requested type, values which can not be converted return zero value
+type Value struct {
+	kind Kind
+	i    int64
+	u    uint64
+	f    float64
+	s    string
+	raw  []byte
+}
+
+// Value return GetFinalValue of h as Value
+func (h *HAvlData) Value() (Value, error) {
+	val, err := h.GetFinalValue()
+	if err != nil {
+		return Value{}, err
+	}
+	return h.newValue(val), nil
+}
+
+// ScaledValue return GetScaledValue of h as Value
+func (h *HAvlData) ScaledValue() (Value, error) {
+	val, err := h.GetScaledValue()
+	if err != nil {
+		return Value{}, err
+	}
+	return h.newValue(val), nil
+}
+
+// newValue wraps a value returned by GetFinalValue or GetScaledValue, raw bytes of the element are kept
+func (h *HAvlData) newValue(val interface{}) Value {
+	v := Value{raw: h.Element.Value}
+	switch x := val.(type) {
+	case bool:
+		v.kind = KindBool
+		if x {
+			v.u, v.i, v.f = 1, 1, 1
+		}
+	case uint8:
+		v.setUint(uint64(x))
+	case uint16:
+		v.setUint(uint64(x))
+	case uint32:
+		v.setUint(uint64(x))
+	case uint64:
+		v.setUint(x)
+	case int8:
+		v.setInt(int64(x))
+	case int16:
+		v.setInt(int64(x))
+	case int32:
+		v.setInt(int64(x))
+	case int64:
+		v.setInt(x)
+	case float64:
+		v.kind = KindFloat
+		v.f = x
+		// conversion of NaN and values out of range is implementation-defined, they stay zero
+		if x >= -1<<63 && x < 1<<63 {
+			v.i = int64(x)
+		}
+		if x >= 0 && x < 1<<64 {
+			v.u = uint64(x)
+		}
+	case string:
+		// elements without a numeric conversion are returned as string by GetFinalValue, only toString means text
+		if h.AvlEncodeKey.FinalConversion == "toString" {
+			v.kind = KindString
+			v.s = x
+		} else {
+			v.kind = KindBytes
+		}
+	}
+	return v
+}
+
+// setUint sets an unsigned integer value
+func (v *Value) setUint(u uint64) {
+	v.kind = KindUint
+	v.u, v.f = u, float64(u)
+	if u <= math.MaxInt64 {
+		v.i = int64(u)
+	}
+}
+
+// setInt sets a signed integer value
+func (v *Value) setInt(i int64) {
+	v.kind = KindInt
+	v.i, v.f = i, float64(i)
+	if i >= 0 {
+		v.u = uint64(i)
+	}
+}
+
+// Kind returns a type of the value
+func (v Value) Kind() Kind {
+	return v.kind
+}
+
+// Int64 returns the value as int64, floats are truncated, true is 1, values out of range of int64 are 0
+func (v Value) Int64() int64 {
+	return v.i
+}
+
+// Uint64 returns the value as uint64, negative values and values out of range of uint64 are 0
+func (v Value) Uint64() uint64 {
+	return v.u
+}
+
+// Float64 returns the value as float64
+func (v Value) Float64() float64 {
+	return v.f
+}
+
+// Bool returns the value as bool, numbers are true if they are not 0
+func (v Value) Bool() bool {
+	switch v.kind {
+	case KindBool, KindUint:
+		return v.u != 0
+	case KindInt:
+		return v.i != 0
+	case KindFloat:
+		return v.f != 0
+	}
+	return false
+}
+
+// String returns the value formatted as text, Bytes kind is formatted as hex
+func (v Value) String() string {
+	switch v.kind {
+	case KindBool:
+		return strconv.FormatBool(v.u != 0)
+	case KindInt:
+		return strconv.FormatInt(v.i, 10)
+	case KindUint:
+		return strconv.FormatUint(v.u, 10)
+	case KindFloat:
+		return strconv.FormatFloat(v.f, 'f', -1, 64)
+	case KindString:
+		return v.s
+	case KindBytes:
+		return hex.EncodeToString(v.raw)
+	}
+	return ""
+}
+
+// Bytes returns raw bytes of IO element the value was converted from
+func (v Value) Bytes() []byte {
+	return v.raw
+}
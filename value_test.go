@@ -0,0 +1,109 @@
+// Copyright 2019 Filip Kroča. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package teltonikaparser
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"math"
+	"testing"
+)
+
+func ExampleHAvlData_Value() {
+	humanDecoder := HumanDecoder{}
+
+	elements := []Element{
+		{Length: 1, IOID: 1, Value: []byte{0x01}},
+		{Length: 2, IOID: 84, Value: []byte{0x04, 0xD2}},
+		{Length: 1, IOID: 32, Value: []byte{0xF6}},
+		{Length: 8, IOID: 78, Value: []byte{0x00, 0x00, 0x01, 0x6B, 0x40, 0xD8, 0xEA, 0x30}},
+	}
+
+	for i := range elements {
+		decoded, err := humanDecoder.Human(&elements[i], "FMBXY")
+		if err != nil {
+			log.Panicf("Error when converting human, %v\n", err)
+		}
+
+		val, err := decoded.ScaledValue()
+		if err != nil {
+			log.Panicf("Unable to ScaledValue() %v", err)
+		}
+		fmt.Printf("%v: %v %v\n", decoded.AvlEncodeKey.PropertyName, val.Kind(), val)
+	}
+
+	// Output:
+	// Digital Input 1: Bool true
+	// Fuel Level: Float 123.4
+	// Coolant Temperature: Int -10
+	// iButton: Bytes 0000016b40d8ea30
+}
+
+func TestValue(t *testing.T) {
+	humanDecoder := HumanDecoder{}
+
+	tests := []struct {
+		el      Element
+		kind    Kind
+		int64   int64
+		uint64  uint64
+		float64 float64
+		bool    bool
+		str     string
+	}{
+		{Element{Length: 1, IOID: 1, Value: []byte{0x01}}, KindBool, 1, 1, 1, true, "true"},
+		{Element{Length: 1, IOID: 21, Value: []byte{0x03}}, KindUint, 3, 3, 3, true, "3"},
+		{Element{Length: 1, IOID: 32, Value: []byte{0xF6}}, KindInt, -10, 0, -10, true, "-10"},
+		{Element{Length: 17, IOID: 256, Value: []byte("WVWZZZ1JZ3W386752")}, KindString, 0, 0, 0, false, "WVWZZZ1JZ3W386752"},
+		{Element{Length: 8, IOID: 78, Value: []byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}}, KindBytes, 0, 0, 0, false, "0000000000000001"},
+	}
+
+	for _, tt := range tests {
+		decoded, err := humanDecoder.Human(&tt.el, "FMBXY")
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, err := decoded.Value()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Kind() != tt.kind || v.Int64() != tt.int64 || v.Uint64() != tt.uint64 || v.Float64() != tt.float64 || v.Bool() != tt.bool || v.String() != tt.str {
+			t.Errorf("IO %v: want %v %v %v %v %v %q, got %v %v %v %v %v %q", tt.el.IOID, tt.kind, tt.int64, tt.uint64, tt.float64, tt.bool, tt.str,
+				v.Kind(), v.Int64(), v.Uint64(), v.Float64(), v.Bool(), v.String())
+		}
+		if !bytes.Equal(v.Bytes(), tt.el.Value) {
+			t.Errorf("IO %v: want raw %x, got %x", tt.el.IOID, tt.el.Value, v.Bytes())
+		}
+	}
+}
+
+func TestValueRange(t *testing.T) {
+	tests := []struct {
+		val    interface{}
+		int64  int64
+		uint64 uint64
+	}{
+		{uint64(math.MaxInt64), math.MaxInt64, math.MaxInt64},
+		{uint64(1 << 63), 0, 1 << 63},
+		{uint64(math.MaxUint64), 0, math.MaxUint64},
+		{int64(math.MinInt64), math.MinInt64, 0},
+		{-2.5, -2, 0},
+		{2.5, 2, 2},
+		{float64(1 << 63), 0, 1 << 63},
+		{float64(1 << 64), 0, 0},
+		{-float64(1 << 64), 0, 0},
+		{math.NaN(), 0, 0},
+		{math.Inf(1), 0, 0},
+	}
+
+	h := HAvlData{Element: &Element{}}
+	for _, tt := range tests {
+		v := h.newValue(tt.val)
+		if v.Int64() != tt.int64 || v.Uint64() != tt.uint64 {
+			t.Errorf("%T %v: want %v %v, got %v %v", tt.val, tt.val, tt.int64, tt.uint64, v.Int64(), v.Uint64())
+		}
+	}
+}